@@ -17,6 +17,10 @@
 package goovn
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+
 	"github.com/ebay/libovsdb"
 )
 
@@ -32,6 +36,39 @@ type ACL struct {
 	Meter      []string
 	Severity   string
 	ExternalID map[interface{}]interface{}
+	Label      int64
+	Tier       int
+	SampleNew  *string
+	SampleEst  *string
+	Options    map[interface{}]interface{}
+}
+
+// ACL options understood by ovn-northd
+const (
+	ACLOptionLogRelated   = "log-related"
+	ACLOptionApplyAfterLB = "apply-after-lb"
+)
+
+// ACLSpec describes an ACL to add, it covers every ACL column. Label, Tier,
+// SampleNew, SampleEst and Options are only written when set so that older
+// schemas without those columns keep working.
+type ACLSpec struct {
+	Name      string
+	Direction string
+	Match     string
+	Action    string
+	Priority  int
+	Log       bool
+	// Meter is ignored when no meter with that name exists
+	Meter    string
+	Severity string
+	Label    int64
+	Tier     int
+	// SampleNew and SampleEst are uuids of Sample rows
+	SampleNew  *string
+	SampleEst  *string
+	Options    map[string]string
+	ExternalID map[string]string
 }
 
 func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVNRow) (string, error) {
@@ -89,6 +126,10 @@ func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVN
 										if cacheACL.Fields["log"].(bool) != value {
 											goto unmatched
 										}
+									case "tier":
+										if tier, _ := cacheACL.Fields["tier"].(int); tier != value {
+											goto unmatched
+										}
 									case "external_ids":
 										if value != nil && !odbi.oMapContians(cacheACL.Fields["external_ids"].(libovsdb.OvsMap).GoMap, value.(*libovsdb.OvsMap).GoMap) {
 											goto unmatched
@@ -130,6 +171,10 @@ func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVN
 								if cacheACL.Fields["log"].(bool) != value {
 									goto out
 								}
+							case "tier":
+								if tier, _ := cacheACL.Fields["tier"].(int); tier != value {
+									goto out
+								}
 							case "external_ids":
 								if value != nil && !odbi.oMapContians(cacheACL.Fields["external_ids"].(libovsdb.OvsMap).GoMap, value.(*libovsdb.OvsMap).GoMap) {
 									goto out
@@ -147,6 +192,42 @@ func (odbi *ovndb) getACLUUIDByRow(entityType EntityType, entity string, row OVN
 }
 
 func (odbi *ovndb) aclAddImp(entityType EntityType, entityName, aclName, direct, match, action string, priority int, external_ids map[string]string, logflag bool, meter, severity string) (*OvnCommand, error) {
+	spec := &ACLSpec{
+		Name:       aclName,
+		Direction:  direct,
+		Match:      match,
+		Action:     action,
+		Priority:   priority,
+		Log:        logflag,
+		Meter:      meter,
+		Severity:   severity,
+		ExternalID: external_ids,
+	}
+	return odbi.aclAddSpecImp(entityType, entityName, spec)
+}
+
+func validateACLSpec(spec *ACLSpec) error {
+	if spec == nil {
+		return fmt.Errorf("acl spec is nil")
+	}
+	if spec.Label < 0 || spec.Label > math.MaxUint32 {
+		return fmt.Errorf("acl label %d out of range", spec.Label)
+	}
+	if spec.Tier < 0 || spec.Tier > 3 {
+		return fmt.Errorf("acl tier %d out of range", spec.Tier)
+	}
+	for k, v := range spec.Options {
+		switch k {
+		case ACLOptionLogRelated, ACLOptionApplyAfterLB:
+			if _, err := strconv.ParseBool(v); err != nil {
+				return ErrorOption
+			}
+		}
+	}
+	return nil
+}
+
+func (odbi *ovndb) aclAddSpecImp(entityType EntityType, entityName string, spec *ACLSpec) (*OvnCommand, error) {
 	var table string
 
 	switch entityType {
@@ -157,17 +238,25 @@ func (odbi *ovndb) aclAddImp(entityType EntityType, entityName, aclName, direct,
 	default:
 		return nil, ErrorOption
 	}
+	if err := validateACLSpec(spec); err != nil {
+		return nil, err
+	}
 
 	namedUUID, err := newRowUUID()
 	if err != nil {
 		return nil, err
 	}
 	row := make(OVNRow)
-	row["direction"] = direct
-	row["match"] = match
-	row["priority"] = priority
+	row["direction"] = spec.Direction
+	row["match"] = spec.Match
+	row["priority"] = spec.Priority
 
-	_, err = odbi.getACLUUIDByRow(entityType, entityName, row)
+	lookup := make(OVNRow)
+	for k, v := range row {
+		lookup[k] = v
+	}
+	lookup["tier"] = spec.Tier
+	_, err = odbi.getACLUUIDByRow(entityType, entityName, lookup)
 	switch err {
 	case ErrorNotFound:
 		break
@@ -177,31 +266,50 @@ func (odbi *ovndb) aclAddImp(entityType EntityType, entityName, aclName, direct,
 		return nil, err
 	}
 
-	if external_ids != nil {
-		oMap, err := libovsdb.NewOvsMap(external_ids)
+	if spec.ExternalID != nil {
+		oMap, err := libovsdb.NewOvsMap(spec.ExternalID)
 		if err != nil {
 			return nil, err
 		}
 		row["external_ids"] = oMap
 	}
 
-	row["name"] = aclName
-	row["action"] = action
-	row["log"] = logflag
-	if logflag {
-		ok := odbi.meterFind(meter)
+	row["name"] = spec.Name
+	row["action"] = spec.Action
+	row["log"] = spec.Log
+	if spec.Log {
+		ok := odbi.meterFind(spec.Meter)
 		if ok {
-			row["meter"] = meter
+			row["meter"] = spec.Meter
 		}
-		switch severity {
+		switch spec.Severity {
 		case "alert", "debug", "info", "notice", "warning":
-			row["severity"] = severity
+			row["severity"] = spec.Severity
 		case "":
 			row["severity"] = "info"
 		default:
 			return nil, ErrorOption
 		}
 	}
+	if spec.Label > 0 {
+		row["label"] = spec.Label
+	}
+	if spec.Tier > 0 {
+		row["tier"] = spec.Tier
+	}
+	if spec.SampleNew != nil {
+		row["sample_new"] = stringToGoUUID(*spec.SampleNew)
+	}
+	if spec.SampleEst != nil {
+		row["sample_est"] = stringToGoUUID(*spec.SampleEst)
+	}
+	if len(spec.Options) > 0 {
+		oMap, err := libovsdb.NewOvsMap(spec.Options)
+		if err != nil {
+			return nil, err
+		}
+		row["options"] = oMap
+	}
 	insertOp := libovsdb.Operation{
 		Op:       opInsert,
 		Table:    TableACL,
@@ -414,6 +522,19 @@ func (odbi *ovndb) rowToACL(uuid string) *ACL {
 		Severity:   severity,
 		ExternalID: cacheACL.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
 	}
+	acl.Label = int64FieldValue(cacheACL.Fields["label"])
+	if tier, ok := cacheACL.Fields["tier"].(int); ok {
+		acl.Tier = tier
+	}
+	if sample, ok := cacheACL.Fields["sample_new"]; ok {
+		acl.SampleNew = odbi.optionalStringFieldToPointer(sample)
+	}
+	if sample, ok := cacheACL.Fields["sample_est"]; ok {
+		acl.SampleEst = odbi.optionalStringFieldToPointer(sample)
+	}
+	if options, ok := cacheACL.Fields["options"].(libovsdb.OvsMap); ok {
+		acl.Options = options.GoMap
+	}
 
 	return acl
 }
@@ -457,19 +457,19 @@ func TestACLs(t *testing.T) {
 			PORT_GROUP, PG_TEST_PG1,
 			[]aclTest{
 				{
-					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil},
+					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil, 0, 0, nil, nil, nil},
 					ACL_NAME_2, MATCH_SECOND, METER1, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil},
+					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil, 0, 0, nil, nil, nil},
 					ACL_NAME_3, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}},
+					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}, 0, 0, nil, nil, nil},
 					ACL_NAME_4, MATCH3, METER1, SEVERITY_WARNING,
 				},
 				{
-					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}},
+					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}, 0, 0, nil, nil, nil},
 					ACL_NAME_5, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 			},
@@ -478,19 +478,19 @@ func TestACLs(t *testing.T) {
 			LOGICAL_SWITCH, PG_TEST_LS1,
 			[]aclTest{
 				{
-					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil},
+					ACL{"", "", "drop", "from-lport", MATCH3, 1001, false, []string{""}, "", nil, 0, 0, nil, nil, nil},
 					ACL_NAME_2, MATCH_SECOND, METER1, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil},
+					ACL{"", ACL_NAME_1, "drop", "to-lport", MATCH, 1001, true, []string{METER1}, SEVERITY_ALERT, nil, 0, 0, nil, nil, nil},
 					ACL_NAME_3, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 				{
-					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}},
+					ACL{"", ACL_NAME_2, "drop", "from-lport", MATCH, 1002, true, []string{METER2}, SEVERITY_INFO, map[interface{}]interface{}{"A": "a", "B": "b"}, 0, 0, nil, nil, nil},
 					ACL_NAME_4, MATCH3, METER1, SEVERITY_WARNING,
 				},
 				{
-					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}},
+					ACL{"", ACL_NAME_3, "drop", "to-lport", MATCH, 1002, true, []string{METER1}, SEVERITY_ALERT, map[interface{}]interface{}{"A": "b", "B": "a"}, 0, 0, nil, nil, nil},
 					ACL_NAME_5, MATCH_SECOND, METER2, SEVERITY_INFO,
 				},
 			},
@@ -664,3 +664,100 @@ func TestACLs(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestACLSpec(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmd, err := ovndbapi.LSAdd(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.PortGroupAdd(PG_TEST_PG1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.ACLAddSpec(PORT_GROUP, PG_TEST_PG1, &ACLSpec{Direction: "to-lport", Match: MATCH, Action: "drop", Priority: 1001, Tier: 4})
+	assert.Error(t, err)
+	_, err = ovndbapi.ACLAddSpec(PORT_GROUP, PG_TEST_PG1, &ACLSpec{Direction: "to-lport", Match: MATCH, Action: "drop", Priority: 1001, Options: map[string]string{ACLOptionApplyAfterLB: "maybe"}})
+	assert.Equal(t, ErrorOption, err)
+
+	for _, e := range []struct {
+		entityType EntityType
+		entity     string
+	}{{LOGICAL_SWITCH, LSW}, {PORT_GROUP, PG_TEST_PG1}} {
+		spec := &ACLSpec{
+			Name:       ACL_NAME_1,
+			Direction:  "from-lport",
+			Match:      MATCH,
+			Action:     "allow-related",
+			Priority:   1001,
+			Log:        true,
+			Severity:   SEVERITY_WARNING,
+			Label:      1234,
+			Tier:       2,
+			Options:    map[string]string{ACLOptionLogRelated: "true", ACLOptionApplyAfterLB: "true"},
+			ExternalID: map[string]string{FOO: BAR},
+		}
+		cmd, err = ovndbapi.ACLAddSpec(e.entityType, e.entity, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = ovndbapi.ACLAddSpec(e.entityType, e.entity, spec)
+		assert.Equal(t, ErrorExist, err)
+		// the same match in another tier is a different ACL
+		other := *spec
+		other.Tier = 1
+		cmd, err = ovndbapi.ACLAddSpec(e.entityType, e.entity, &other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		acls, err := ovndbapi.ACLListEntity(e.entityType, e.entity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 2, len(acls))
+		for _, acl := range acls {
+			assert.Equal(t, ACL_NAME_1, acl.Name)
+			assert.Equal(t, SEVERITY_WARNING, acl.Severity)
+			assert.Equal(t, int64(1234), acl.Label)
+			assert.Equal(t, "true", acl.Options[ACLOptionLogRelated])
+			assert.Equal(t, "true", acl.Options[ACLOptionApplyAfterLB])
+			assert.Equal(t, BAR, acl.ExternalID[FOO])
+			cmd, err = ovndbapi.ACLDelEntity(e.entityType, e.entity, acl.UUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = ovndbapi.Execute(cmd)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		assert.ElementsMatch(t, []int{1, 2}, []int{acls[0].Tier, acls[1].Tier})
+	}
+
+	cmd, err = ovndbapi.LSDel(LSW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err = ovndbapi.PortGroupDel(PG_TEST_PG1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -76,6 +76,9 @@ type OVNSignal interface {
 	OnMeterBandCreate(band *MeterBand)
 	OnMeterBandDelete(band *MeterBand)
 
+	OnForwardingGroupCreate(group *ForwardingGroup)
+	OnForwardingGroupDelete(group *ForwardingGroup)
+
 	// Create/delete chassis from south bound db
 	OnChassisCreate(ch *Chassis)
 	OnChassisDelete(ch *Chassis)
@@ -83,6 +86,28 @@ type OVNSignal interface {
 	// Create/delete encap from south bound db
 	OnEncapCreate(ch *Encap)
 	OnEncapDelete(ch *Encap)
+
+	// Port binding claimed by a chassis or released from it in south bound db,
+	// on unbind pb.Chassis is the chassis the port was bound to
+	OnPortBindingBind(pb *PortBinding)
+	OnPortBindingUnbind(pb *PortBinding)
+
+	// Create/delete IGMP group and multicast group from south bound db
+	OnIGMPGroupCreate(group *IGMPGroup)
+	OnIGMPGroupDelete(group *IGMPGroup)
+	OnMulticastGroupCreate(group *MulticastGroup)
+	OnMulticastGroupDelete(group *MulticastGroup)
+
+	// Controller event reported in south bound db. ControllerEventAck may be
+	// called from here, execute its command from another goroutine
+	OnControllerEvent(ev *ControllerEvent)
+
+	// Service monitor status set or changed by ovn-controller, oldStatus is nil if it was unset
+	OnServiceMonitorStatusChange(sm *ServiceMonitor, oldStatus *string)
+
+	// Chassis liveness transitions, see Config.ChassisStaleThreshold
+	OnChassisStale(l *ChassisLiveness)
+	OnChassisRecovered(l *ChassisLiveness)
 }
 
 // OVNNotifier ovnnb and ovnsb notifier
@@ -0,0 +1,210 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// BFD ovnnb item
+type BFD struct {
+	UUID        string
+	LogicalPort string
+	DstIP       string
+	MinTx       *int
+	MinRx       *int
+	DetectMult  *int
+	Status      *string
+	Options     map[interface{}]interface{}
+	ExternalID  map[interface{}]interface{}
+}
+
+func newBFDParamsRow(minTx, minRx, detectMult *int) (OVNRow, error) {
+	row := make(OVNRow)
+	if minTx != nil {
+		if *minTx < 1 {
+			return nil, ErrorOption
+		}
+		row["min_tx"] = *minTx
+	}
+	if minRx != nil {
+		if *minRx < 0 {
+			return nil, ErrorOption
+		}
+		row["min_rx"] = *minRx
+	}
+	if detectMult != nil {
+		if *detectMult < 1 {
+			return nil, ErrorOption
+		}
+		row["detect_mult"] = *detectMult
+	}
+	return row, nil
+}
+
+func (odbi *ovndb) bfdAddImp(logicalPort, dstIP string, minTx, minRx, detectMult *int, external_ids map[string]string) (*OvnCommand, error) {
+	if len(logicalPort) == 0 || len(dstIP) == 0 {
+		return nil, fmt.Errorf("logical port and destination ip are required")
+	}
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := newBFDParamsRow(minTx, minRx, detectMult)
+	if err != nil {
+		return nil, err
+	}
+	row["logical_port"] = logicalPort
+	row["dst_ip"] = dstIP
+
+	if uuid := odbi.getBFDUUID(logicalPort, dstIP); len(uuid) > 0 {
+		return nil, ErrorExist
+	}
+
+	if external_ids != nil {
+		oMap, err := libovsdb.NewOvsMap(external_ids)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableBFD,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) bfdUpdateImp(logicalPort, dstIP string, minTx, minRx, detectMult *int) (*OvnCommand, error) {
+	uuid := odbi.getBFDUUID(logicalPort, dstIP)
+	if len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	row, err := newBFDParamsRow(minTx, minRx, detectMult)
+	if err != nil {
+		return nil, err
+	}
+	if len(row) == 0 {
+		return nil, ErrorNoChanges
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableBFD,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) bfdDelImp(logicalPort, dstIP string) (*OvnCommand, error) {
+	uuid := odbi.getBFDUUID(logicalPort, dstIP)
+	if len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	// Static routes only hold a weak reference to the BFD row,
+	// ovsdb-server clears it for us.
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableBFD,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) getBFDUUID(logicalPort, dstIP string) string {
+	row := make(OVNRow)
+	row["logical_port"] = logicalPort
+	row["dst_ip"] = dstIP
+	return odbi.getRowUUID(TableBFD, row)
+}
+
+// bfdGetImp returns the BFD sessions on logicalPort, limited to dstIP when it
+// is not empty
+func (odbi *ovndb) bfdGetImp(logicalPort, dstIP string) ([]*BFD, error) {
+	var listBFD []*BFD
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheBFD, ok := odbi.cache[TableBFD]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheBFD {
+		if lp, ok := drows.Fields["logical_port"].(string); !ok || lp != logicalPort {
+			continue
+		}
+		if ip, ok := drows.Fields["dst_ip"].(string); len(dstIP) > 0 && (!ok || ip != dstIP) {
+			continue
+		}
+		listBFD = append(listBFD, odbi.rowToBFD(uuid))
+	}
+	return listBFD, nil
+}
+
+func (odbi *ovndb) bfdListImp() ([]*BFD, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheBFD, ok := odbi.cache[TableBFD]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listBFD := make([]*BFD, 0, len(cacheBFD))
+	for uuid := range cacheBFD {
+		listBFD = append(listBFD, odbi.rowToBFD(uuid))
+	}
+	return listBFD, nil
+}
+
+func (odbi *ovndb) rowToBFD(uuid string) *BFD {
+	cacheBFD, ok := odbi.cache[TableBFD][uuid]
+	if !ok {
+		return nil
+	}
+
+	bfd := &BFD{
+		UUID:        uuid,
+		LogicalPort: cacheBFD.Fields["logical_port"].(string),
+		DstIP:       cacheBFD.Fields["dst_ip"].(string),
+		MinTx:       odbi.optionalIntFieldToPointer(cacheBFD.Fields["min_tx"]),
+		MinRx:       odbi.optionalIntFieldToPointer(cacheBFD.Fields["min_rx"]),
+		DetectMult:  odbi.optionalIntFieldToPointer(cacheBFD.Fields["detect_mult"]),
+		Status:      odbi.optionalStringFieldToPointer(cacheBFD.Fields["status"]),
+		ExternalID:  cacheBFD.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if options, ok := cacheBFD.Fields["options"].(libovsdb.OvsMap); ok {
+		bfd.Options = options.GoMap
+	}
+	return bfd
+}
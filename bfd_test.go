@@ -0,0 +1,143 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LR_BFD    = "lr-bfd"
+	LRP_BFD   = "lrp-bfd"
+	BFD_DSTIP = "172.16.0.254"
+)
+
+func TestBFD(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmd, err := ovndbapi.LRAdd(LR_BFD, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("Adding BFD session to %s on %s", BFD_DSTIP, LRP_BFD)
+	minTx, detectMult := 500, 3
+	cmd, err = ovndbapi.BFDAdd(LRP_BFD, BFD_DSTIP, &minTx, nil, &detectMult, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.BFDAdd(LRP_BFD, BFD_DSTIP, nil, nil, nil, nil)
+	assert.Equal(t, ErrorExist, err)
+
+	bfds, err := ovndbapi.BFDGet(LRP_BFD, BFD_DSTIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bfds) != 1 {
+		t.Fatalf("BFD session not created %v", bfds)
+	}
+	assert.Equal(t, minTx, *bfds[0].MinTx)
+	assert.Equal(t, detectMult, *bfds[0].DetectMult)
+	assert.Nil(t, bfds[0].MinRx)
+	bfdUUID := bfds[0].UUID
+
+	minRx := 1000
+	cmd, err = ovndbapi.BFDUpdate(LRP_BFD, BFD_DSTIP, nil, &minRx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bfds, err = ovndbapi.BFDGet(LRP_BFD, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, minRx, *bfds[0].MinRx)
+	assert.Equal(t, minTx, *bfds[0].MinTx)
+
+	t.Logf("Attaching BFD session to static route")
+	cmd, err = ovndbapi.LRSRAdd(LR_BFD, "10.10.0.0/16", BFD_DSTIP, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrsr, err := ovndbapi.LRSRList(LR_BFD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lrsr) != 1 {
+		t.Fatalf("static route not created %v", lrsr)
+	}
+	cmd, err = ovndbapi.LRSRSetBFD(lrsr[0].UUID, &bfdUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrsr, err = ovndbapi.LRSRList(LR_BFD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lrsr[0].BFD == nil || *lrsr[0].BFD != bfdUUID {
+		t.Fatalf("BFD not attached to static route %+v", lrsr[0])
+	}
+
+	t.Logf("Deleting BFD session clears the static route reference")
+	cmd, err = ovndbapi.BFDDel(LRP_BFD, BFD_DSTIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bfds, err = ovndbapi.BFDList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(bfds))
+	lrsr, err = ovndbapi.LRSRList(LR_BFD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, lrsr[0].BFD)
+
+	cmd, err = ovndbapi.LRDel(LR_BFD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -18,14 +18,28 @@ package goovn
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/ebay/libovsdb"
 )
 
+// Chassis other_config keys, older ovn-controller sets them in external_ids
+const (
+	ChassisBridgeMappings string = "ovn-bridge-mappings"
+	ChassisDatapathType   string = "datapath-type"
+	ChassisIfaceTypes     string = "iface-types"
+	ChassisCMSOptions     string = "ovn-cms-options"
+	ChassisIsInterconn    string = "is-interconn"
+	// ovn-cms-options flag of chassis eligible for gateway router ports
+	ChassisCMSOptionGateway string = "enable-chassis-as-gw"
+)
+
 // Chassis table OVN SB
 type Chassis struct {
 	UUID                string
 	Encaps              []string
 	ExternalID          map[interface{}]interface{}
+	OtherConfig         map[interface{}]interface{}
 	Hostname            string
 	Name                string
 	NbCfg               int
@@ -187,6 +201,9 @@ func (odbi *ovndb) rowToChassis(uuid string) (*Chassis, error) {
 		ExternalID: cacheChassis.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
 		NbCfg:      cacheChassis.Fields["nb_cfg"].(int),
 	}
+	if oc, ok := cacheChassis.Fields["other_config"].(libovsdb.OvsMap); ok {
+		ch.OtherConfig = oc.GoMap
+	}
 
 	if tz, ok := cacheChassis.Fields["transport_zones"]; ok {
 		switch tz.(type) {
@@ -228,3 +245,82 @@ func (odbi *ovndb) rowToChassis(uuid string) (*Chassis, error) {
 	ch.Encaps = encaps
 	return ch, nil
 }
+
+// ChassisCapabilities holds the typed view of the configuration
+// ovn-controller reports in chassis other_config
+type ChassisCapabilities struct {
+	DatapathType string
+	IsInterconn  bool
+	// CMSOptions holds ovn-cms-options, flags without value map to ""
+	CMSOptions     map[string]string
+	bridgeMappings map[string]string
+	ifaceTypes     []string
+}
+
+// Capabilities parses the chassis configuration, other_config keys take
+// precedence over the external_ids ones set by older ovn-controller
+func (ch *Chassis) Capabilities() *ChassisCapabilities {
+	get := func(key string) string {
+		if v, ok := ch.OtherConfig[key].(string); ok {
+			return v
+		}
+		if v, ok := ch.ExternalID[key].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	caps := &ChassisCapabilities{
+		DatapathType:   get(ChassisDatapathType),
+		IsInterconn:    get(ChassisIsInterconn) == "true",
+		CMSOptions:     make(map[string]string),
+		bridgeMappings: make(map[string]string),
+	}
+	for _, opt := range splitCommaList(get(ChassisCMSOptions)) {
+		kv := strings.SplitN(opt, "=", 2)
+		if len(kv) == 2 {
+			caps.CMSOptions[kv[0]] = kv[1]
+		} else {
+			caps.CMSOptions[kv[0]] = ""
+		}
+	}
+	for _, mapping := range splitCommaList(get(ChassisBridgeMappings)) {
+		kv := strings.SplitN(mapping, ":", 2)
+		if len(kv) == 2 && len(kv[0]) > 0 && len(kv[1]) > 0 {
+			caps.bridgeMappings[kv[0]] = kv[1]
+		}
+	}
+	caps.ifaceTypes = splitCommaList(get(ChassisIfaceTypes))
+	return caps
+}
+
+// IsGateway tells if the chassis is eligible to host gateway router ports
+func (caps *ChassisCapabilities) IsGateway() bool {
+	_, ok := caps.CMSOptions[ChassisCMSOptionGateway]
+	return ok
+}
+
+// BridgeMappings returns the physical network to bridge mappings
+func (caps *ChassisCapabilities) BridgeMappings() map[string]string {
+	mappings := make(map[string]string, len(caps.bridgeMappings))
+	for k, v := range caps.bridgeMappings {
+		mappings[k] = v
+	}
+	return mappings
+}
+
+// SupportedIfaceTypes returns the interface types supported by the chassis datapath
+func (caps *ChassisCapabilities) SupportedIfaceTypes() []string {
+	return append([]string(nil), caps.ifaceTypes...)
+}
+
+// splitCommaList splits a comma separated list dropping empty items
+func splitCommaList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -92,3 +92,125 @@ func TestEncaps(t *testing.T) {
 		assert.EqualError(t, ErrorNotFound, err.Error())
 	}
 }
+
+func TestEncapManagement(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	ocmd, err := ovndbapi.ChassisAdd(CHASSIS_NAME, CHASSIS_HOSTNAME, []string{"geneve"}, IP, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.EncapAdd(CHASSIS_NAME, "geneve", IP, nil)
+	assert.Equal(t, ErrorExist, err)
+	_, err = ovndbapi.EncapAdd(FAKENOCHASSIS, "vxlan", IP, nil)
+	assert.Equal(t, ErrorNotFound, err)
+	_, err = ovndbapi.EncapAdd(CHASSIS_NAME, "vxlan", IP, map[string]string{EncapOptionDstPort: "0"})
+	assert.Equal(t, ErrorOption, err)
+	ocmd, err = ovndbapi.EncapAdd(CHASSIS_NAME, "vxlan", IP, map[string]string{EncapOptionDstPort: "4790"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.EncapSetOptions(CHASSIS_NAME, "geneve", map[string]string{EncapOptionLocalIP: "not-an-ip"})
+	assert.Equal(t, ErrorOption, err)
+	ocmd, err = ovndbapi.EncapSetOptions(CHASSIS_NAME, "geneve", map[string]string{EncapOptionCsum: "true", EncapOptionLocalIP: IP2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocmd, err = ovndbapi.EncapSetOptions(CHASSIS_NAME, "geneve", map[string]string{EncapOptionCsum: "false"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encaps, err := ovndbapi.EncapList(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(encaps))
+	for _, encap := range encaps {
+		switch encap.Encaptype {
+		case "geneve":
+			assert.Equal(t, map[interface{}]interface{}{EncapOptionCsum: "false", EncapOptionLocalIP: IP2}, encap.Options)
+		case "vxlan":
+			assert.Equal(t, "4790", encap.Options[EncapOptionDstPort])
+		}
+	}
+
+	ocmd, err = ovndbapi.EncapDelOptions(CHASSIS_NAME, "geneve", []string{EncapOptionLocalIP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch, err := ovndbapi.ChassisGetByEncapIP(IP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CHASSIS_NAME, ch.Name)
+	_, err = ovndbapi.ChassisGetByEncapIP(IP2)
+	assert.Equal(t, ErrorNotFound, err)
+
+	_, err = ovndbapi.EncapSetIP(CHASSIS_NAME, "geneve", "not-an-ip")
+	assert.Error(t, err)
+	_, err = ovndbapi.EncapSetIP(CHASSIS_NAME, "stt", IP2)
+	assert.Equal(t, ErrorNotFound, err)
+	_, err = ovndbapi.EncapSetIP(CHASSIS_NAME, "geneve", IP)
+	assert.Equal(t, ErrorNoChanges, err)
+	ocmd, err = ovndbapi.EncapSetIP(CHASSIS_NAME, "geneve", IP2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err = ovndbapi.ChassisGetByEncapIP(IP2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, CHASSIS_NAME, ch.Name)
+
+	ocmd, err = ovndbapi.EncapDel(CHASSIS_NAME, "vxlan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encaps, err = ovndbapi.EncapList(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(encaps))
+	assert.Equal(t, map[interface{}]interface{}{EncapOptionCsum: "false"}, encaps[0].Options)
+	_, err = ovndbapi.EncapDel(CHASSIS_NAME, "geneve")
+	assert.Error(t, err)
+
+	ocmd, err = ovndbapi.ChassisDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,246 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultChassisStaleThreshold is used by ChassisLivenessList when
+// Config.ChassisStaleThreshold is not set
+const DefaultChassisStaleThreshold = 60 * time.Second
+
+// minChassisLivenessInterval bounds how often the tracker checks liveness
+const minChassisLivenessInterval = time.Second
+
+// ChassisLiveness reports how far a chassis is behind SB_Global nb_cfg.
+// ovn-controller acknowledges nb_cfg only when it changes, so a chassis is
+// stale once it stays behind for longer than the threshold, not when its
+// last acknowledgement is old. The CMS is expected to bump NB_Global
+// nb_cfg for liveness to be checked.
+type ChassisLiveness struct {
+	Chassis     string
+	ChassisUUID string
+	// NbCfg is the last nb_cfg acknowledged by the chassis, TargetNbCfg the
+	// one of SB_Global
+	NbCfg       int
+	TargetNbCfg int
+	// LastSeen is zero if the chassis never acknowledged nb_cfg
+	LastSeen time.Time
+	// BehindSince is when the client first saw SB_Global nb_cfg ahead of
+	// the chassis, zero if the chassis is up to date
+	BehindSince time.Time
+	Stale       bool
+}
+
+// nbCfgHistory records when the client first saw each SB_Global nb_cfg
+type nbCfgHistory struct {
+	mutex sync.Mutex
+	seen  []nbCfgSeen
+}
+
+type nbCfgSeen struct {
+	nbCfg int
+	at    time.Time
+}
+
+func (h *nbCfgHistory) record(nbCfg int, at time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if n := len(h.seen); n > 0 {
+		if nbCfg == h.seen[n-1].nbCfg {
+			return
+		}
+		// nb_cfg went back, the database was reset
+		if nbCfg < h.seen[n-1].nbCfg {
+			h.seen = h.seen[:0]
+		}
+	}
+	h.seen = append(h.seen, nbCfgSeen{nbCfg, at})
+}
+
+// behindSince returns when nb_cfg was first seen above acked, zero if never
+func (h *nbCfgHistory) behindSince(acked int) time.Time {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for _, s := range h.seen {
+		if s.nbCfg > acked {
+			return s.at
+		}
+	}
+	return time.Time{}
+}
+
+// prune forgets the values acknowledged by every chassis, keeping the latest
+func (h *nbCfgHistory) prune(minAcked int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	i := 0
+	for i < len(h.seen)-1 && h.seen[i].nbCfg <= minAcked {
+		i++
+	}
+	h.seen = h.seen[i:]
+}
+
+func (odbi *ovndb) chassisLivenessListImp() ([]*ChassisLiveness, error) {
+	threshold := DefaultChassisStaleThreshold
+	if odbi.liveness != nil {
+		threshold = odbi.liveness.threshold
+	}
+	return odbi.chassisLivenessAt(time.Now(), threshold)
+}
+
+func (odbi *ovndb) chassisLivenessAt(now time.Time, threshold time.Duration) ([]*ChassisLiveness, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheChassis, ok := odbi.cache[TableChassis]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	cacheGlobal, ok := odbi.cache[TableSBGlobal]
+	if !ok || odbi.nbCfgHistory == nil {
+		return nil, ErrorSchema
+	}
+	var target int
+	for _, drows := range cacheGlobal {
+		target = int(int64FieldValue(drows.Fields["nb_cfg"]))
+	}
+	privateByName := odbi.chassisPrivateByName()
+	listLiveness := make([]*ChassisLiveness, 0, len(cacheChassis))
+	for uuid, drows := range cacheChassis {
+		l := &ChassisLiveness{ChassisUUID: uuid, TargetNbCfg: target}
+		l.Chassis, _ = drows.Fields["name"].(string)
+		l.NbCfg = int(int64FieldValue(drows.Fields["nb_cfg"]))
+		if privUUID, ok := privateByName[l.Chassis]; ok {
+			fields := odbi.cache[TableChassisPrivate][privUUID].Fields
+			l.NbCfg = int(int64FieldValue(fields["nb_cfg"]))
+			if ts := int64FieldValue(fields["nb_cfg_timestamp"]); ts > 0 {
+				l.LastSeen = time.Unix(0, ts*int64(time.Millisecond))
+			}
+		}
+		if l.NbCfg < target {
+			l.BehindSince = odbi.nbCfgHistory.behindSince(l.NbCfg)
+			l.Stale = !l.BehindSince.IsZero() && now.Sub(l.BehindSince) > threshold
+		}
+		listLiveness = append(listLiveness, l)
+	}
+	return listLiveness, nil
+}
+
+// chassisPrivateByName maps chassis names to the uuid of their
+// Chassis_Private row, which shares the name, caller must hold cachemutex
+func (odbi *ovndb) chassisPrivateByName() map[string]string {
+	privateByName := make(map[string]string)
+	for uuid, drows := range odbi.cache[TableChassisPrivate] {
+		if name, ok := drows.Fields["name"].(string); ok {
+			privateByName[name] = uuid
+		}
+	}
+	return privateByName
+}
+
+// recordNbCfg records a new SB_Global nb_cfg and forgets the values every
+// chassis acknowledged, caller must hold cachemutex
+func (odbi *ovndb) recordNbCfg(nbCfg int, at time.Time) {
+	odbi.nbCfgHistory.record(nbCfg, at)
+
+	privateByName := odbi.chassisPrivateByName()
+	minAcked := nbCfg
+	for _, drows := range odbi.cache[TableChassis] {
+		name, _ := drows.Fields["name"].(string)
+		acked := int(int64FieldValue(drows.Fields["nb_cfg"]))
+		if privUUID, ok := privateByName[name]; ok {
+			acked = int(int64FieldValue(odbi.cache[TableChassisPrivate][privUUID].Fields["nb_cfg"]))
+		}
+		if acked < minAcked {
+			minAcked = acked
+		}
+	}
+	odbi.nbCfgHistory.prune(minAcked)
+}
+
+// chassisLivenessTracker periodically reports chassis liveness transitions
+// to the signal callback
+type chassisLivenessTracker struct {
+	odbi      *ovndb
+	signalCB  OVNSignal
+	threshold time.Duration
+	// stale state of the chassis by uuid, only touched by run
+	stale    map[string]bool
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newChassisLivenessTracker(odbi *ovndb, threshold time.Duration) *chassisLivenessTracker {
+	tracker := &chassisLivenessTracker{
+		odbi:      odbi,
+		signalCB:  odbi.signalCB,
+		threshold: threshold,
+		stale:     make(map[string]bool),
+		done:      make(chan struct{}),
+	}
+	go tracker.run()
+	return tracker
+}
+
+func (tracker *chassisLivenessTracker) run() {
+	interval := tracker.threshold / 2
+	if interval < minChassisLivenessInterval {
+		interval = minChassisLivenessInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-tracker.done:
+			return
+		case now := <-ticker.C:
+			tracker.check(now)
+		}
+	}
+}
+
+func (tracker *chassisLivenessTracker) check(now time.Time) {
+	listLiveness, err := tracker.odbi.chassisLivenessAt(now, tracker.threshold)
+	if err != nil {
+		return
+	}
+	present := make(map[string]bool, len(listLiveness))
+	for _, l := range listLiveness {
+		present[l.ChassisUUID] = true
+		wasStale := tracker.stale[l.ChassisUUID]
+		tracker.stale[l.ChassisUUID] = l.Stale
+		if l.Stale && !wasStale {
+			tracker.signalCB.OnChassisStale(l)
+		} else if !l.Stale && wasStale {
+			tracker.signalCB.OnChassisRecovered(l)
+		}
+	}
+	for uuid := range tracker.stale {
+		if !present[uuid] {
+			delete(tracker.stale, uuid)
+		}
+	}
+}
+
+func (tracker *chassisLivenessTracker) stop() {
+	tracker.stopOnce.Do(func() {
+		close(tracker.done)
+	})
+}
@@ -0,0 +1,166 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+// livenessSignal records liveness transitions
+type livenessSignal struct {
+	signal
+	stale     []string
+	recovered []string
+}
+
+func (s *livenessSignal) OnChassisStale(l *ChassisLiveness) {
+	s.stale = append(s.stale, l.Chassis)
+}
+
+func (s *livenessSignal) OnChassisRecovered(l *ChassisLiveness) {
+	s.recovered = append(s.recovered, l.Chassis)
+}
+
+func TestChassisLiveness(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	ovn := ovndbapi.(*ovndb)
+	ocmd, err := ovndbapi.ChassisAdd(CHASSIS_NAME, CHASSIS_HOSTNAME, ENCAP_TYPES, IP, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocmd, err = ovn.chassisPrivateAdd(CHASSIS_NAME, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chassis, err := ovndbapi.ChassisGet(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ocmd, err = ovn.sbGlobalAdd(map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an old acknowledgement is fine as long as nb_cfg did not move
+	ts := time.Now().Add(-time.Hour).UnixNano() / int64(time.Millisecond)
+	condition := libovsdb.NewCondition("name", "==", CHASSIS_NAME)
+	row := OVNRow{"nb_cfg_timestamp": ts, "chassis": stringToGoUUID(chassis[0].UUID)}
+	operations := []libovsdb.Operation{{Op: opUpdate, Table: TableChassisPrivate, Row: row, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovn, make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chPrivate, err := ovndbapi.ChassisPrivateGet(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ts, chPrivate[0].NbCfgTimestamp)
+	assert.Equal(t, chassis[0].UUID, *chPrivate[0].Chassis)
+	listLiveness, err := ovndbapi.ChassisLivenessList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(listLiveness))
+	assert.False(t, listLiveness[0].Stale)
+	assert.True(t, listLiveness[0].BehindSince.IsZero())
+	assert.Equal(t, ts, listLiveness[0].LastSeen.UnixNano()/int64(time.Millisecond))
+
+	// northd copies a bumped nb_cfg into SB_Global
+	now := time.Now()
+	var globalUUID string
+	ovn.cachemutex.RLock()
+	for uuid := range ovn.cache[TableSBGlobal] {
+		globalUUID = uuid
+	}
+	ovn.cachemutex.RUnlock()
+	globalCondition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(globalUUID))
+	operations = []libovsdb.Operation{{Op: opUpdate, Table: TableSBGlobal, Row: OVNRow{"nb_cfg": 3}, Where: []interface{}{globalCondition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovn, make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	listLiveness, err = ovndbapi.ChassisLivenessList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, listLiveness[0].TargetNbCfg)
+	assert.False(t, listLiveness[0].BehindSince.IsZero())
+	assert.False(t, listLiveness[0].Stale)
+
+	recorder := &livenessSignal{}
+	tracker := &chassisLivenessTracker{
+		odbi:      ovn,
+		signalCB:  recorder,
+		threshold: time.Minute,
+		stale:     make(map[string]bool),
+	}
+	tracker.check(now)
+	assert.Empty(t, recorder.stale)
+	tracker.check(now.Add(2 * time.Minute))
+	assert.Equal(t, []string{CHASSIS_NAME}, recorder.stale)
+	// no event until the state changes again
+	tracker.check(now.Add(3 * time.Minute))
+	assert.Equal(t, 1, len(recorder.stale))
+
+	// acknowledge nb_cfg as ovn-controller does
+	row = OVNRow{"nb_cfg": 3, "nb_cfg_timestamp": time.Now().UnixNano() / int64(time.Millisecond)}
+	operations = []libovsdb.Operation{{Op: opUpdate, Table: TableChassisPrivate, Row: row, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovn, make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker.check(now.Add(4 * time.Minute))
+	assert.Equal(t, []string{CHASSIS_NAME}, recorder.recovered)
+
+	ocmd, err = ovn.sbGlobalDel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocmd, err = ovndbapi.ChassisPrivateDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocmd2, err := ovndbapi.ChassisDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd, ocmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
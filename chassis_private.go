@@ -27,6 +27,10 @@ type ChassisPrivate struct {
 	ExternalID map[interface{}]interface{}
 	Name       string
 	NbCfg      int
+	// NbCfgTimestamp is when nb_cfg was last acknowledged, in milliseconds since the epoch
+	NbCfgTimestamp int64
+	// Chassis is the uuid of the Chassis row, nil if not set
+	Chassis *string
 }
 
 func (odbi *ovndb) chassisPrivateAddImp(chName string,
@@ -136,6 +140,8 @@ func (odbi *ovndb) rowToChassisPrivate(uuid string) (*ChassisPrivate, error) {
 		ExternalID: cacheChassisPrivate.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
 		Name:       cacheChassisPrivate.Fields["name"].(string),
 		NbCfg:      cacheChassisPrivate.Fields["nb_cfg"].(int),
+		Chassis:    odbi.optionalStringFieldToPointer(cacheChassisPrivate.Fields["chassis"]),
 	}
+	chPrivate.NbCfgTimestamp = int64FieldValue(cacheChassisPrivate.Fields["nb_cfg_timestamp"])
 	return chPrivate, nil
 }
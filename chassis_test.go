@@ -18,6 +18,9 @@ package goovn
 
 import (
 	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -148,3 +151,71 @@ func TestChassis(t *testing.T) {
 	}
 	t.Logf("Chassis %s deleted", chName)
 }
+
+func TestChassisCapabilities(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	// older ovn-controller reports its configuration in external_ids
+	legacy := map[string]string{
+		ChassisBridgeMappings: "physnet1:br-ex, physnet2:br-eth1",
+		ChassisCMSOptions:     "enable-chassis-as-gw,availability-zones=az1",
+		ChassisDatapathType:   "system",
+	}
+	ocmd, err := ovndbapi.ChassisAdd(CHASSIS_NAME, CHASSIS_HOSTNAME, ENCAP_TYPES, IP, legacy, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chassis, err := ovndbapi.ChassisGet(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chassis) != 1 {
+		t.Fatalf("err getting chassis, total:%v", len(chassis))
+	}
+	caps := chassis[0].Capabilities()
+	assert.True(t, caps.IsGateway())
+	assert.False(t, caps.IsInterconn)
+	assert.Equal(t, "system", caps.DatapathType)
+	assert.Equal(t, "az1", caps.CMSOptions["availability-zones"])
+	assert.Equal(t, map[string]string{"physnet1": "br-ex", "physnet2": "br-eth1"}, caps.BridgeMappings())
+	assert.Empty(t, caps.SupportedIfaceTypes())
+
+	// other_config takes precedence over external_ids
+	otherConfig, err := libovsdb.NewOvsMap(map[string]string{
+		ChassisBridgeMappings: "physnet1:br-phys",
+		ChassisCMSOptions:     "",
+		ChassisIfaceTypes:     "geneve,internal,patch,vxlan",
+		ChassisIsInterconn:    "true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	condition := libovsdb.NewCondition("name", "==", CHASSIS_NAME)
+	operations := []libovsdb.Operation{{Op: opUpdate, Table: TableChassis, Row: OVNRow{"other_config": otherConfig}, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chassis, err = ovndbapi.ChassisGet(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caps = chassis[0].Capabilities()
+	assert.False(t, caps.IsGateway())
+	assert.True(t, caps.IsInterconn)
+	assert.Equal(t, "system", caps.DatapathType)
+	assert.Equal(t, map[string]string{"physnet1": "br-phys"}, caps.BridgeMappings())
+	assert.Equal(t, []string{"geneve", "internal", "patch", "vxlan"}, caps.SupportedIfaceTypes())
+
+	ocmd, err = ovndbapi.ChassisDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
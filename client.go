@@ -49,6 +49,8 @@ type Client interface {
 	LSExtIdsAdd(ls string, external_ids map[string]string) (*OvnCommand, error)
 	// Del external_ids from logical_switch
 	LSExtIdsDel(ls string, external_ids map[string]string) (*OvnCommand, error)
+	// Set multicast snooping config of LSW, nil fields are left untouched
+	LSSetMulticastConfig(ls string, cfg *LSMulticastConfig) (*OvnCommand, error)
 	// Link logical switch to router
 	LinkSwitchToRouter(lsw, lsp, lr, lrp, lrpMac string, networks []string, externalIds map[string]string) (*OvnCommand, error)
 
@@ -74,7 +76,9 @@ type Client interface {
 	// List Load balancers for a LSW
 	LSLBList(ls string) ([]*LoadBalancer, error)
 
-	// Add ACL to entity (PORT_GROUP or LOGICAL_SWITCH)
+	// Add ACL described by spec to entity (PORT_GROUP or LOGICAL_SWITCH)
+	ACLAddSpec(entityType EntityType, entityName string, spec *ACLSpec) (*OvnCommand, error)
+	// Deprecated in favor of ACLAddSpec(). Add ACL to entity (PORT_GROUP or LOGICAL_SWITCH)
 	ACLAddEntity(entityType EntityType, entityName, aclName, direct, match, action string, priority int, external_ids map[string]string, logflag bool, meter, severity string) (*OvnCommand, error)
 	// Deprecated in favor of ACLAddEntity(). Add ACL to logical switch.
 	ACLAdd(ls, direct, match, action string, priority int, external_ids map[string]string, logflag bool, meter string, severity string) (*OvnCommand, error)
@@ -112,6 +116,16 @@ type Client interface {
 	LRDel(name string) (*OvnCommand, error)
 	// Get LRs
 	LRList() ([]*LogicalRouter, error)
+	// Set options in LR, keys not given are kept
+	LRSetOptions(name string, options map[string]string) (*OvnCommand, error)
+	// Delete options from LR
+	LRDelOptions(name string, keys []string) (*OvnCommand, error)
+	// Get options from LR
+	LRGetOptions(name string) (map[string]string, error)
+	// Enable or disable LR
+	LRSetEnabled(name string, enabled bool) (*OvnCommand, error)
+	// Enable or disable multicast relay on LR
+	LRSetMulticastRelay(name string, enabled bool) (*OvnCommand, error)
 
 	// Add LRP with given name on given lr
 	LRPAdd(lr string, lrp string, mac string, network []string, peer string, external_ids map[string]string) (*OvnCommand, error)
@@ -119,6 +133,24 @@ type Client interface {
 	LRPDel(lr string, lrp string) (*OvnCommand, error)
 	// Get all lrp by lr
 	LRPList(lr string) ([]*LogicalRouterPort, error)
+	// Set route table used for traffic from lrp, empty string for the main table
+	LRPSetRouteTable(lrp string, routeTable string) (*OvnCommand, error)
+	// Get LRP by name
+	LRPGet(lrp string) (*LogicalRouterPort, error)
+	// Enable or disable LRP
+	LRPSetEnabled(lrp string, enabled bool) (*OvnCommand, error)
+	// Set mac of LRP
+	LRPSetMAC(lrp string, mac string) (*OvnCommand, error)
+	// Set networks of LRP
+	LRPSetNetworks(lrp string, networks []string) (*OvnCommand, error)
+	// Set options in LRP, keys not given are kept
+	LRPSetOptions(lrp string, options map[string]string) (*OvnCommand, error)
+	// Delete options from LRP
+	LRPDelOptions(lrp string, keys []string) (*OvnCommand, error)
+	// Set ipv6_ra_configs in LRP, keys not given are kept
+	LRPSetIPv6RAConfigs(lrp string, configs map[string]string) (*OvnCommand, error)
+	// Delete ipv6_ra_configs from LRP
+	LRPDelIPv6RAConfigs(lrp string, keys []string) (*OvnCommand, error)
 
 	// Add LRSR with given ip_prefix on given lr
 	LRSRAdd(lr string, ip_prefix string, nexthop string, output_port *string, policy *string, external_ids map[string]string) (*OvnCommand, error)
@@ -126,8 +158,25 @@ type Client interface {
 	LRSRDel(lr string, prefix string, nexthop, outputPort, policy *string) (*OvnCommand, error)
 	// Delete LRSR by uuid given lr
 	LRSRDelByUUID(lr, uuid string) (*OvnCommand, error)
+	// Add LRSR described by spec on given lr, with ecmp routes to the same prefix via other nexthops are allowed
+	LRSRAddSpec(lr string, spec *LRSRSpec, ecmp bool) (*OvnCommand, error)
+	// Delete LRSRs matching spec on given lr, an empty nexthop deletes all ECMP members and a nil policy any policy
+	LRSRDelSpec(lr string, spec *LRSRSpec) (*OvnCommand, error)
 	// Get all LRSRs by lr
 	LRSRList(lr string) ([]*LogicalRouterStaticRoute, error)
+	// Attach BFD session with given uuid to LRSR, a nil bfd detaches it
+	LRSRSetBFD(uuid string, bfd *string) (*OvnCommand, error)
+
+	// Add BFD session to dst_ip on logical_port, nil parameters are left to OVN defaults
+	BFDAdd(logicalPort, dstIP string, minTx, minRx, detectMult *int, external_ids map[string]string) (*OvnCommand, error)
+	// Update timers of BFD session, nil parameters are not changed
+	BFDUpdate(logicalPort, dstIP string, minTx, minRx, detectMult *int) (*OvnCommand, error)
+	// Delete BFD session
+	BFDDel(logicalPort, dstIP string) (*OvnCommand, error)
+	// Get BFD sessions on logical_port, optionally filtered by dst_ip
+	BFDGet(logicalPort, dstIP string) ([]*BFD, error)
+	// Get all BFD sessions
+	BFDList() ([]*BFD, error)
 
 	// Add LRPolicy
 	LRPolicyAdd(lr string, priority int, match string, action string, nexthop *string, nexthops []string, options map[string]string, external_ids map[string]string) (*OvnCommand, error)
@@ -155,11 +204,89 @@ type Client interface {
 	LBDel(name string) (*OvnCommand, error)
 	// Update existing LB
 	LBUpdate(name string, vipPort string, protocol string, addrs []string) (*OvnCommand, error)
+	// Add a VIP with its backends to an existing LB, leaving other VIPs untouched
+	LBVIPAdd(name string, vip string, addrs []string) (*OvnCommand, error)
+	// Delete a VIP from LB
+	LBVIPDel(name string, vip string) (*OvnCommand, error)
+	// Replace the backends of a VIP on LB, adding the VIP if it does not exist
+	LBVIPSetBackends(name string, vip string, addrs []string) (*OvnCommand, error)
 	// Set selection fields for LB session affinity
 	LBSetSelectionFields(name string, selectionFields string) (*OvnCommand, error)
+	// Set options on LB, keys not given are kept
+	LBSetOptions(name string, options map[string]string) (*OvnCommand, error)
+	// Get options from LB
+	LBGetOptions(name string) (map[string]string, error)
 	// Get LBs
 	LBList() ([]*LoadBalancer, error)
 
+	// Get LB group with given name
+	LBGroupGet(name string) ([]*LoadBalancerGroup, error)
+	// Add LB group with optional LBs given by name
+	LBGroupAdd(name string, lbs []string) (*OvnCommand, error)
+	// Delete LB group and its references from logical switches and routers
+	LBGroupDel(name string) (*OvnCommand, error)
+	// Add LB to LB group
+	LBGroupAddLB(group string, lb string) (*OvnCommand, error)
+	// Delete LB from LB group
+	LBGroupDelLB(group string, lb string) (*OvnCommand, error)
+	// Get LB groups
+	LBGroupList() ([]*LoadBalancerGroup, error)
+	// Add LB group to LSW
+	LSLBGroupAdd(ls string, group string) (*OvnCommand, error)
+	// Delete LB group from LSW
+	LSLBGroupDel(ls string, group string) (*OvnCommand, error)
+	// Add LB group to LR
+	LRLBGroupAdd(lr string, group string) (*OvnCommand, error)
+	// Delete LB group from LR
+	LRLBGroupDel(lr string, group string) (*OvnCommand, error)
+
+	// Add forwarding group to LSW
+	FwdGroupAdd(ls string, name string, vip string, vmac string, childPorts []string, liveness bool, external_ids map[string]string) (*OvnCommand, error)
+	// Delete forwarding group
+	FwdGroupDel(name string) (*OvnCommand, error)
+	// Add child port to forwarding group
+	FwdGroupAddPort(name string, port string) (*OvnCommand, error)
+	// Delete child port from forwarding group
+	FwdGroupDelPort(name string, port string) (*OvnCommand, error)
+	// Set liveness of forwarding group
+	FwdGroupSetLiveness(name string, liveness bool) (*OvnCommand, error)
+	// Get forwarding group by name
+	FwdGroupGet(name string) (*ForwardingGroup, error)
+	// List forwarding groups of LSW
+	FwdGroupList(ls string) ([]*ForwardingGroup, error)
+
+	// Add Copp mapping control plane protocols to meter names
+	CoppAdd(name string, meters map[string]string, external_ids map[string]string) (*OvnCommand, error)
+	// Delete Copp
+	CoppDel(name string) (*OvnCommand, error)
+	// Set meters of Copp, protocols not given are kept
+	CoppSetMeters(name string, meters map[string]string) (*OvnCommand, error)
+	// Delete meters of the given protocols from Copp
+	CoppDelMeters(name string, protocols []string) (*OvnCommand, error)
+	// Get Copp by name
+	CoppGet(name string) (*Copp, error)
+	// List Copps
+	CoppList() ([]*Copp, error)
+	// Set Copp of LSW
+	LSSetCopp(ls string, copp string) (*OvnCommand, error)
+	// Clear Copp of LSW
+	LSClearCopp(ls string) (*OvnCommand, error)
+	// Set Copp of LR
+	LRSetCopp(lr string, copp string) (*OvnCommand, error)
+	// Clear Copp of LR
+	LRClearCopp(lr string) (*OvnCommand, error)
+
+	// Add static mac bindings, all bindings are added in one command
+	StaticMACBindingAdd(bindings ...*StaticMACBinding) (*OvnCommand, error)
+	// Update mac and override_dynamic_mac of a static mac binding
+	StaticMACBindingUpdate(binding *StaticMACBinding) (*OvnCommand, error)
+	// Delete static mac bindings matched by logical port and ip, all bindings are deleted in one command
+	StaticMACBindingDel(bindings ...*StaticMACBinding) (*OvnCommand, error)
+	// Get static mac binding of ip on a router port
+	StaticMACBindingGet(lrp string, ip string) (*StaticMACBinding, error)
+	// List static mac bindings of a router port, or all of them if lrp is empty
+	StaticMACBindingList(lrp string) ([]*StaticMACBinding, error)
+
 	// Set dhcp4_options uuid on lsp
 	LSPSetDHCPv4Options(lsp string, options string) (*OvnCommand, error)
 	// Get dhcp4_options from lsp
@@ -236,6 +363,97 @@ type Client interface {
 
 	// Get encaps by chassis name
 	EncapList(chname string) ([]*Encap, error)
+	// Add encap of type encapType to existing chassis
+	EncapAdd(chassis string, encapType string, ip string, options map[string]string) (*OvnCommand, error)
+	// Delete encap of type encapType from chassis, the last encap cannot be deleted
+	EncapDel(chassis string, encapType string) (*OvnCommand, error)
+	// Set options (csum, dst_port, local_ip, ...) of the chassis encap of type encapType
+	EncapSetOptions(chassis string, encapType string, options map[string]string) (*OvnCommand, error)
+	// Delete options of the chassis encap of type encapType
+	EncapDelOptions(chassis string, encapType string, keys []string) (*OvnCommand, error)
+	// Set the tunnel ip of the chassis encap of type encapType
+	EncapSetIP(chassis string, encapType string, ip string) (*OvnCommand, error)
+	// Get chassis owning an encap with the given tunnel ip
+	ChassisGetByEncapIP(ip string) (*Chassis, error)
+
+	// Get port binding by logical port name
+	PortBindingGet(logicalPort string) (*PortBinding, error)
+	// List port bindings
+	PortBindingList() ([]*PortBinding, error)
+	// List port bindings bound to chassis with given name or hostname
+	PortBindingListByChassis(chassis string) ([]*PortBinding, error)
+
+	// List datapath bindings
+	DatapathBindingList() ([]*DatapathBinding, error)
+	// Get datapath binding of NB logical switch or router by its uuid
+	DatapathBindingGetByNBUUID(nbUUID string) (*DatapathBinding, error)
+	// Get datapath binding by tunnel key
+	DatapathBindingGetByTunnelKey(tunnelKey int) (*DatapathBinding, error)
+
+	// List logical flows matching filter, ordered like ovn-sbctl lflow-list.
+	// Logical_Flow is only monitored when listed in Config.TableCols
+	LogicalFlowList(filter *LogicalFlowFilter) ([]*LogicalFlow, error)
+	// List logical flows matching filter grouped by datapath, like ovn-sbctl lflow-list
+	LogicalFlowListByDatapath(filter *LogicalFlowFilter) ([]*DatapathLogicalFlows, error)
+
+	// List mac bindings matching filter
+	MACBindingList(filter *MACBindingFilter) ([]*MACBinding, error)
+	// Delete all mac bindings matching filter, which cannot be empty
+	MACBindingDel(filter *MACBindingFilter) (*OvnCommand, error)
+	// Delete all mac bindings for which match returns true, match must not call the client
+	MACBindingDelFunc(match func(*MACBinding) bool) (*OvnCommand, error)
+
+	// List FDB entries
+	FDBList() ([]*FDB, error)
+	// List FDB entries learnt on logical port
+	FDBListByPort(logicalPort string) ([]*FDB, error)
+	// Delete all FDB entries learnt on logical port
+	FDBFlushPort(logicalPort string) (*OvnCommand, error)
+
+	// List IGMP groups of datapath given by uuid or name, all of them if datapath is empty
+	IGMPGroupList(datapath string) ([]*IGMPGroup, error)
+	// List multicast groups of datapath given by uuid or name, all of them if datapath is empty
+	MulticastGroupList(datapath string) ([]*MulticastGroup, error)
+
+	// List pending controller events
+	ControllerEventList() ([]*ControllerEvent, error)
+	// Acknowledge controller event by deleting its row, does not check the event exists
+	ControllerEventAck(uuid string) (*OvnCommand, error)
+
+	// List south bound load balancers of datapath given by uuid or name, all of them if datapath is empty
+	SBLoadBalancerList(datapath string) ([]*SBLoadBalancer, error)
+	// List south bound meters with their bands
+	SBMeterList() ([]*SBMeter, error)
+	// Get south bound meter by name
+	SBMeterGet(name string) (*SBMeter, error)
+	// List south bound DNS rows of datapath given by uuid or name, all of them if datapath is empty
+	SBDNSList(datapath string) ([]*SBDNS, error)
+
+	// List service monitors matching filter, all of them if filter is nil
+	ServiceMonitorList(filter *ServiceMonitorFilter) ([]*ServiceMonitor, error)
+
+	// Report IPsec readiness of every chassis, including encap options agreeing across chassis, requiredKeys are ovn-ipsec-* keys each chassis must set
+	ChassisIPsecStatusList(requiredKeys []string) ([]*ChassisIPsecStatus, error)
+
+	// List nb_cfg acknowledgement time and staleness of every chassis
+	ChassisLivenessList() ([]*ChassisLiveness, error)
+
+	// Add RBAC role with no permissions
+	RBACRoleAdd(role string) (*OvnCommand, error)
+	// Delete RBAC role along with its permissions
+	RBACRoleDel(role string) (*OvnCommand, error)
+	// Get RBAC role by name
+	RBACRoleGet(role string) (*RBACRole, error)
+	// List RBAC roles
+	RBACRoleList() ([]*RBACRole, error)
+	// Set the permission of RBAC role on perm.Table, replacing the existing one
+	RBACPermissionSet(role string, perm *RBACPermission) (*OvnCommand, error)
+	// Delete the permission of RBAC role on table
+	RBACPermissionDel(role string, table string) (*OvnCommand, error)
+	// List permissions of RBAC role
+	RBACPermissionList(role string) ([]*RBACPermission, error)
+	// Install the ovn-controller RBAC role and its standard permissions, ErrorNoChanges if already installed
+	RBACInstallControllerRole() (*OvnCommand, error)
 
 	// Set NB_Global table options
 	NBGlobalSetOptions(options map[string]string) (*OvnCommand, error)
@@ -243,12 +461,38 @@ type Client interface {
 	// Get NB_Global table options
 	NBGlobalGetOptions() (map[string]string, error)
 
+	// Get NB_Global table row
+	NBGlobalGet() (*NBGlobalTableRow, error)
+	// Increment NB_Global nb_cfg, for the chassis to report when they caught up
+	NBGlobalIncNbCfg() (*OvnCommand, error)
+	// Set NB_Global name
+	NBGlobalSetName(name string) (*OvnCommand, error)
+	// Set NB_Global external_ids, keeping the other keys
+	NBGlobalSetExternalIDs(external_ids map[string]string) (*OvnCommand, error)
+	// Set NB_Global options:mac_prefix, the first three bytes of dynamic MAC addresses
+	NBGlobalSetMACPrefix(prefix string) (*OvnCommand, error)
+	// Set NB_Global options:svc_monitor_mac
+	NBGlobalSetSvcMonitorMAC(mac string) (*OvnCommand, error)
+	// Set NB_Global options:northd_probe_interval in milliseconds, 0 disables it
+	NBGlobalSetNorthdProbeInterval(interval int) (*OvnCommand, error)
+	// Set NB_Global options:use_logical_dp_groups
+	NBGlobalSetUseLogicalDPGroups(enable bool) (*OvnCommand, error)
+	// Turn IPsec encryption of tunnel traffic on or off
+	NBGlobalSetIPSec(enable bool) (*OvnCommand, error)
+
 	// Set SB_Global table options
 	SBGlobalSetOptions(options map[string]string) (*OvnCommand, error)
 
 	// Get SB_Global table options
 	SBGlobalGetOptions() (map[string]string, error)
 
+	// Get SB_Global table row
+	SBGlobalGet() (*SBGlobalTableRow, error)
+	// Set SB_Global external_ids, keeping the other keys
+	SBGlobalSetExternalIDs(external_ids map[string]string) (*OvnCommand, error)
+	// Set a single SB_Global option, keeping the others
+	SBGlobalSetOption(key string, value string) (*OvnCommand, error)
+
 	// Creates a new port group in the Port_Group table named "group" with optional "ports"  and "external_ids".
 	PortGroupAdd(group string, ports []string, external_ids map[string]string) (*OvnCommand, error)
 	// Sets "ports" and/or "external_ids" on the port group named "group". It is an error if group does not exist.
@@ -289,6 +533,8 @@ type ovndb struct {
 	tableCols    map[string][]string
 	tlsConfig    *tls.Config
 	reconn       bool
+	liveness     *chassisLivenessTracker
+	nbCfgHistory *nbCfgHistory
 }
 
 func connect(c *ovndb) (err error) {
@@ -336,10 +582,17 @@ func NewClient(cfg *Config) (Client, error) {
 		reconn:       cfg.Reconnect,
 	}
 
+	if db == DBSB {
+		// filled from the initial monitor reply on, so set before connecting
+		ovndb.nbCfgHistory = &nbCfgHistory{}
+	}
 	err := connect(ovndb)
 	if err != nil {
 		return nil, err
 	}
+	if db == DBSB && cfg.SignalCB != nil && cfg.ChassisStaleThreshold > 0 {
+		ovndb.liveness = newChassisLivenessTracker(ovndb, cfg.ChassisStaleThreshold)
+	}
 	return ovndb, err
 }
 
@@ -411,9 +664,17 @@ func (c *ovndb) MonitorTables(jsonContext interface{}) (*libovsdb.TableUpdates,
 			}
 		}
 	} else {
+		optIn := make(map[string]bool)
+		if c.db == DBSB {
+			for _, table := range SBOptInTables {
+				optIn[table] = true
+			}
+		}
 		c.tableCols = make(map[string][]string)
 		for _, table := range tables {
-			c.tableCols[table] = []string{}
+			if !optIn[table] {
+				c.tableCols[table] = []string{}
+			}
 		}
 	}
 	requests := make(map[string]libovsdb.MonitorRequest)
@@ -432,6 +693,9 @@ func (c *ovndb) MonitorTables(jsonContext interface{}) (*libovsdb.TableUpdates,
 
 // TODO return proper error
 func (c *ovndb) Close() error {
+	if c.liveness != nil {
+		c.liveness.stop()
+	}
 	c.client.Disconnect()
 	return nil
 }
@@ -444,6 +708,162 @@ func (c *ovndb) EncapList(chname string) ([]*Encap, error) {
 	return c.encapListImp(chname)
 }
 
+func (c *ovndb) EncapAdd(chassis string, encapType string, ip string, options map[string]string) (*OvnCommand, error) {
+	return c.encapAddImp(chassis, encapType, ip, options)
+}
+
+func (c *ovndb) EncapDel(chassis string, encapType string) (*OvnCommand, error) {
+	return c.encapDelImp(chassis, encapType)
+}
+
+func (c *ovndb) EncapSetOptions(chassis string, encapType string, options map[string]string) (*OvnCommand, error) {
+	return c.encapSetOptionsImp(chassis, encapType, options)
+}
+
+func (c *ovndb) EncapDelOptions(chassis string, encapType string, keys []string) (*OvnCommand, error) {
+	return c.encapDelOptionsImp(chassis, encapType, keys)
+}
+
+func (c *ovndb) EncapSetIP(chassis string, encapType string, ip string) (*OvnCommand, error) {
+	return c.encapSetIPImp(chassis, encapType, ip)
+}
+
+func (c *ovndb) ChassisGetByEncapIP(ip string) (*Chassis, error) {
+	return c.chassisGetByEncapIPImp(ip)
+}
+
+func (c *ovndb) PortBindingGet(logicalPort string) (*PortBinding, error) {
+	return c.portBindingGetImp(logicalPort)
+}
+
+func (c *ovndb) PortBindingList() ([]*PortBinding, error) {
+	return c.portBindingListImp()
+}
+
+func (c *ovndb) PortBindingListByChassis(chassis string) ([]*PortBinding, error) {
+	return c.portBindingListByChassisImp(chassis)
+}
+
+func (c *ovndb) DatapathBindingList() ([]*DatapathBinding, error) {
+	return c.datapathBindingListImp()
+}
+
+func (c *ovndb) DatapathBindingGetByNBUUID(nbUUID string) (*DatapathBinding, error) {
+	return c.datapathBindingGetByNBUUIDImp(nbUUID)
+}
+
+func (c *ovndb) DatapathBindingGetByTunnelKey(tunnelKey int) (*DatapathBinding, error) {
+	return c.datapathBindingGetByTunnelKeyImp(tunnelKey)
+}
+
+func (c *ovndb) LogicalFlowList(filter *LogicalFlowFilter) ([]*LogicalFlow, error) {
+	return c.logicalFlowListImp(filter)
+}
+
+func (c *ovndb) LogicalFlowListByDatapath(filter *LogicalFlowFilter) ([]*DatapathLogicalFlows, error) {
+	return c.logicalFlowListByDatapathImp(filter)
+}
+
+func (c *ovndb) MACBindingList(filter *MACBindingFilter) ([]*MACBinding, error) {
+	return c.macBindingListImp(filter)
+}
+
+func (c *ovndb) MACBindingDel(filter *MACBindingFilter) (*OvnCommand, error) {
+	return c.macBindingDelImp(filter)
+}
+
+func (c *ovndb) MACBindingDelFunc(match func(*MACBinding) bool) (*OvnCommand, error) {
+	return c.macBindingDelFuncImp(match)
+}
+
+func (c *ovndb) FDBList() ([]*FDB, error) {
+	return c.fdbListImp()
+}
+
+func (c *ovndb) FDBListByPort(logicalPort string) ([]*FDB, error) {
+	return c.fdbListByPortImp(logicalPort)
+}
+
+func (c *ovndb) FDBFlushPort(logicalPort string) (*OvnCommand, error) {
+	return c.fdbFlushPortImp(logicalPort)
+}
+
+func (c *ovndb) IGMPGroupList(datapath string) ([]*IGMPGroup, error) {
+	return c.igmpGroupListImp(datapath)
+}
+
+func (c *ovndb) MulticastGroupList(datapath string) ([]*MulticastGroup, error) {
+	return c.multicastGroupListImp(datapath)
+}
+
+func (c *ovndb) ControllerEventList() ([]*ControllerEvent, error) {
+	return c.controllerEventListImp()
+}
+
+func (c *ovndb) ControllerEventAck(uuid string) (*OvnCommand, error) {
+	return c.controllerEventAckImp(uuid)
+}
+
+func (c *ovndb) SBLoadBalancerList(datapath string) ([]*SBLoadBalancer, error) {
+	return c.sbLoadBalancerListImp(datapath)
+}
+
+func (c *ovndb) SBMeterList() ([]*SBMeter, error) {
+	return c.sbMeterListImp()
+}
+
+func (c *ovndb) SBMeterGet(name string) (*SBMeter, error) {
+	return c.sbMeterGetImp(name)
+}
+
+func (c *ovndb) SBDNSList(datapath string) ([]*SBDNS, error) {
+	return c.sbDNSListImp(datapath)
+}
+
+func (c *ovndb) ServiceMonitorList(filter *ServiceMonitorFilter) ([]*ServiceMonitor, error) {
+	return c.serviceMonitorListImp(filter)
+}
+
+func (c *ovndb) ChassisIPsecStatusList(requiredKeys []string) ([]*ChassisIPsecStatus, error) {
+	return c.chassisIPsecStatusListImp(requiredKeys)
+}
+
+func (c *ovndb) ChassisLivenessList() ([]*ChassisLiveness, error) {
+	return c.chassisLivenessListImp()
+}
+
+func (c *ovndb) RBACRoleAdd(role string) (*OvnCommand, error) {
+	return c.rbacRoleAddImp(role)
+}
+
+func (c *ovndb) RBACRoleDel(role string) (*OvnCommand, error) {
+	return c.rbacRoleDelImp(role)
+}
+
+func (c *ovndb) RBACRoleGet(role string) (*RBACRole, error) {
+	return c.rbacRoleGetImp(role)
+}
+
+func (c *ovndb) RBACRoleList() ([]*RBACRole, error) {
+	return c.rbacRoleListImp()
+}
+
+func (c *ovndb) RBACPermissionSet(role string, perm *RBACPermission) (*OvnCommand, error) {
+	return c.rbacPermissionSetImp(role, perm)
+}
+
+func (c *ovndb) RBACPermissionDel(role string, table string) (*OvnCommand, error) {
+	return c.rbacPermissionDelImp(role, table)
+}
+
+func (c *ovndb) RBACPermissionList(role string) ([]*RBACPermission, error) {
+	return c.rbacPermissionListImp(role)
+}
+
+func (c *ovndb) RBACInstallControllerRole() (*OvnCommand, error) {
+	return c.rbacInstallControllerRoleImp()
+}
+
 func (c *ovndb) ChassisGet(name string) ([]*Chassis, error) {
 	return c.chassisGetImp(name)
 }
@@ -497,6 +917,10 @@ func (c *ovndb) LSExtIdsDel(ls string, external_ids map[string]string) (*OvnComm
 	return c.lsExtIdsDelImp(ls, external_ids)
 }
 
+func (c *ovndb) LSSetMulticastConfig(ls string, cfg *LSMulticastConfig) (*OvnCommand, error) {
+	return c.lsSetMulticastConfigImp(ls, cfg)
+}
+
 func (c *ovndb) LSPGet(lsp string) (*LogicalSwitchPort, error) {
 	return c.lspGetImp(lsp)
 }
@@ -589,6 +1013,26 @@ func (c *ovndb) LRList() ([]*LogicalRouter, error) {
 	return c.lrListImp()
 }
 
+func (c *ovndb) LRSetOptions(name string, options map[string]string) (*OvnCommand, error) {
+	return c.lrSetOptionsImp(name, options)
+}
+
+func (c *ovndb) LRDelOptions(name string, keys []string) (*OvnCommand, error) {
+	return c.lrDelOptionsImp(name, keys)
+}
+
+func (c *ovndb) LRGetOptions(name string) (map[string]string, error) {
+	return c.lrGetOptionsImp(name)
+}
+
+func (c *ovndb) LRSetEnabled(name string, enabled bool) (*OvnCommand, error) {
+	return c.lrSetEnabledImp(name, enabled)
+}
+
+func (c *ovndb) LRSetMulticastRelay(name string, enabled bool) (*OvnCommand, error) {
+	return c.lrSetMulticastRelayImp(name, enabled)
+}
+
 func (c *ovndb) LRPAdd(lr string, lrp string, mac string, network []string, peer string, external_ids map[string]string) (*OvnCommand, error) {
 	return c.lrpAddImp(lr, lrp, mac, network, peer, external_ids)
 }
@@ -601,6 +1045,42 @@ func (c *ovndb) LRPList(lr string) ([]*LogicalRouterPort, error) {
 	return c.lrpListImp(lr)
 }
 
+func (c *ovndb) LRPSetRouteTable(lrp string, routeTable string) (*OvnCommand, error) {
+	return c.lrpSetRouteTableImp(lrp, routeTable)
+}
+
+func (c *ovndb) LRPGet(lrp string) (*LogicalRouterPort, error) {
+	return c.lrpGetImp(lrp)
+}
+
+func (c *ovndb) LRPSetEnabled(lrp string, enabled bool) (*OvnCommand, error) {
+	return c.lrpSetEnabledImp(lrp, enabled)
+}
+
+func (c *ovndb) LRPSetMAC(lrp string, mac string) (*OvnCommand, error) {
+	return c.lrpSetMACImp(lrp, mac)
+}
+
+func (c *ovndb) LRPSetNetworks(lrp string, networks []string) (*OvnCommand, error) {
+	return c.lrpSetNetworksImp(lrp, networks)
+}
+
+func (c *ovndb) LRPSetOptions(lrp string, options map[string]string) (*OvnCommand, error) {
+	return c.lrpSetOptionsImp(lrp, options)
+}
+
+func (c *ovndb) LRPDelOptions(lrp string, keys []string) (*OvnCommand, error) {
+	return c.lrpDelOptionsImp(lrp, keys)
+}
+
+func (c *ovndb) LRPSetIPv6RAConfigs(lrp string, configs map[string]string) (*OvnCommand, error) {
+	return c.lrpSetIPv6RAConfigsImp(lrp, configs)
+}
+
+func (c *ovndb) LRPDelIPv6RAConfigs(lrp string, keys []string) (*OvnCommand, error) {
+	return c.lrpDelIPv6RAConfigsImp(lrp, keys)
+}
+
 func (c *ovndb) LRSRAdd(lr string, ip_prefix string, nexthop string, output_port *string, policy *string, external_ids map[string]string) (*OvnCommand, error) {
 	return c.lrsrAddImp(lr, ip_prefix, nexthop, output_port, policy, external_ids)
 }
@@ -613,10 +1093,42 @@ func (c *ovndb) LRSRDelByUUID(lr, uuid string) (*OvnCommand, error) {
 	return c.lrsrDelByUUIDImp(lr, uuid)
 }
 
+func (c *ovndb) LRSRAddSpec(lr string, spec *LRSRSpec, ecmp bool) (*OvnCommand, error) {
+	return c.lrsrAddSpecImp(lr, spec, ecmp)
+}
+
+func (c *ovndb) LRSRDelSpec(lr string, spec *LRSRSpec) (*OvnCommand, error) {
+	return c.lrsrDelSpecImp(lr, spec)
+}
+
 func (c *ovndb) LRSRList(lr string) ([]*LogicalRouterStaticRoute, error) {
 	return c.lrsrListImp(lr)
 }
 
+func (c *ovndb) LRSRSetBFD(uuid string, bfd *string) (*OvnCommand, error) {
+	return c.lrsrSetBFDImp(uuid, bfd)
+}
+
+func (c *ovndb) BFDAdd(logicalPort, dstIP string, minTx, minRx, detectMult *int, external_ids map[string]string) (*OvnCommand, error) {
+	return c.bfdAddImp(logicalPort, dstIP, minTx, minRx, detectMult, external_ids)
+}
+
+func (c *ovndb) BFDUpdate(logicalPort, dstIP string, minTx, minRx, detectMult *int) (*OvnCommand, error) {
+	return c.bfdUpdateImp(logicalPort, dstIP, minTx, minRx, detectMult)
+}
+
+func (c *ovndb) BFDDel(logicalPort, dstIP string) (*OvnCommand, error) {
+	return c.bfdDelImp(logicalPort, dstIP)
+}
+
+func (c *ovndb) BFDGet(logicalPort, dstIP string) ([]*BFD, error) {
+	return c.bfdGetImp(logicalPort, dstIP)
+}
+
+func (c *ovndb) BFDList() ([]*BFD, error) {
+	return c.bfdListImp()
+}
+
 func (c *ovndb) LRLBAdd(lr string, lb string) (*OvnCommand, error) {
 	return c.lrlbAddImp(lr, lb)
 }
@@ -661,14 +1173,166 @@ func (c *ovndb) LBDel(name string) (*OvnCommand, error) {
 	return c.lbDelImp(name)
 }
 
+func (c *ovndb) LBVIPAdd(name string, vip string, addrs []string) (*OvnCommand, error) {
+	return c.lbVIPAddImp(name, vip, addrs)
+}
+
+func (c *ovndb) LBVIPDel(name string, vip string) (*OvnCommand, error) {
+	return c.lbVIPDelImp(name, vip)
+}
+
+func (c *ovndb) LBVIPSetBackends(name string, vip string, addrs []string) (*OvnCommand, error) {
+	return c.lbVIPSetBackendsImp(name, vip, addrs)
+}
+
 func (c *ovndb) LBSetSelectionFields(name string, selectionFields string) (*OvnCommand, error) {
 	return c.lbSetSelectionFieldsImp(name, selectionFields)
 }
 
+func (c *ovndb) LBSetOptions(name string, options map[string]string) (*OvnCommand, error) {
+	return c.lbSetOptionsImp(name, options)
+}
+
+func (c *ovndb) LBGetOptions(name string) (map[string]string, error) {
+	return c.lbGetOptionsImp(name)
+}
+
 func (c *ovndb) LBList() ([]*LoadBalancer, error) {
 	return c.lbListImp()
 }
 
+func (c *ovndb) LBGroupGet(name string) ([]*LoadBalancerGroup, error) {
+	return c.lbGroupGetImp(name)
+}
+
+func (c *ovndb) LBGroupAdd(name string, lbs []string) (*OvnCommand, error) {
+	return c.lbGroupAddImp(name, lbs)
+}
+
+func (c *ovndb) LBGroupDel(name string) (*OvnCommand, error) {
+	return c.lbGroupDelImp(name)
+}
+
+func (c *ovndb) LBGroupAddLB(group string, lb string) (*OvnCommand, error) {
+	return c.lbGroupAddLBImp(group, lb)
+}
+
+func (c *ovndb) LBGroupDelLB(group string, lb string) (*OvnCommand, error) {
+	return c.lbGroupDelLBImp(group, lb)
+}
+
+func (c *ovndb) LBGroupList() ([]*LoadBalancerGroup, error) {
+	return c.lbGroupListImp()
+}
+
+func (c *ovndb) LSLBGroupAdd(ls string, group string) (*OvnCommand, error) {
+	return c.lsLBGroupAddImp(ls, group)
+}
+
+func (c *ovndb) LSLBGroupDel(ls string, group string) (*OvnCommand, error) {
+	return c.lsLBGroupDelImp(ls, group)
+}
+
+func (c *ovndb) LRLBGroupAdd(lr string, group string) (*OvnCommand, error) {
+	return c.lrLBGroupAddImp(lr, group)
+}
+
+func (c *ovndb) LRLBGroupDel(lr string, group string) (*OvnCommand, error) {
+	return c.lrLBGroupDelImp(lr, group)
+}
+
+func (c *ovndb) FwdGroupAdd(ls string, name string, vip string, vmac string, childPorts []string, liveness bool, external_ids map[string]string) (*OvnCommand, error) {
+	return c.fwdGroupAddImp(ls, name, vip, vmac, childPorts, liveness, external_ids)
+}
+
+func (c *ovndb) FwdGroupDel(name string) (*OvnCommand, error) {
+	return c.fwdGroupDelImp(name)
+}
+
+func (c *ovndb) FwdGroupAddPort(name string, port string) (*OvnCommand, error) {
+	return c.fwdGroupAddPortImp(name, port)
+}
+
+func (c *ovndb) FwdGroupDelPort(name string, port string) (*OvnCommand, error) {
+	return c.fwdGroupDelPortImp(name, port)
+}
+
+func (c *ovndb) FwdGroupSetLiveness(name string, liveness bool) (*OvnCommand, error) {
+	return c.fwdGroupSetLivenessImp(name, liveness)
+}
+
+func (c *ovndb) FwdGroupGet(name string) (*ForwardingGroup, error) {
+	return c.fwdGroupGetImp(name)
+}
+
+func (c *ovndb) FwdGroupList(ls string) ([]*ForwardingGroup, error) {
+	return c.fwdGroupListImp(ls)
+}
+
+func (c *ovndb) CoppAdd(name string, meters map[string]string, external_ids map[string]string) (*OvnCommand, error) {
+	return c.coppAddImp(name, meters, external_ids)
+}
+
+func (c *ovndb) CoppDel(name string) (*OvnCommand, error) {
+	return c.coppDelImp(name)
+}
+
+func (c *ovndb) CoppSetMeters(name string, meters map[string]string) (*OvnCommand, error) {
+	return c.coppSetMetersImp(name, meters)
+}
+
+func (c *ovndb) CoppDelMeters(name string, protocols []string) (*OvnCommand, error) {
+	return c.coppDelMetersImp(name, protocols)
+}
+
+func (c *ovndb) CoppGet(name string) (*Copp, error) {
+	return c.coppGetImp(name)
+}
+
+func (c *ovndb) CoppList() ([]*Copp, error) {
+	return c.coppListImp()
+}
+
+func (c *ovndb) LSSetCopp(ls string, copp string) (*OvnCommand, error) {
+	return c.lsSetCoppImp(ls, copp)
+}
+
+func (c *ovndb) LSClearCopp(ls string) (*OvnCommand, error) {
+	return c.lsClearCoppImp(ls)
+}
+
+func (c *ovndb) LRSetCopp(lr string, copp string) (*OvnCommand, error) {
+	return c.lrSetCoppImp(lr, copp)
+}
+
+func (c *ovndb) LRClearCopp(lr string) (*OvnCommand, error) {
+	return c.lrClearCoppImp(lr)
+}
+
+func (c *ovndb) StaticMACBindingAdd(bindings ...*StaticMACBinding) (*OvnCommand, error) {
+	return c.staticMACBindingAddImp(bindings...)
+}
+
+func (c *ovndb) StaticMACBindingUpdate(binding *StaticMACBinding) (*OvnCommand, error) {
+	return c.staticMACBindingUpdateImp(binding)
+}
+
+func (c *ovndb) StaticMACBindingDel(bindings ...*StaticMACBinding) (*OvnCommand, error) {
+	return c.staticMACBindingDelImp(bindings...)
+}
+
+func (c *ovndb) StaticMACBindingGet(lrp string, ip string) (*StaticMACBinding, error) {
+	return c.staticMACBindingGetImp(lrp, ip)
+}
+
+func (c *ovndb) StaticMACBindingList(lrp string) ([]*StaticMACBinding, error) {
+	return c.staticMACBindingListImp(lrp)
+}
+
+func (c *ovndb) ACLAddSpec(entityType EntityType, entityName string, spec *ACLSpec) (*OvnCommand, error) {
+	return c.aclAddSpecImp(entityType, entityName, spec)
+}
+
 func (c *ovndb) ACLAddEntity(entityType EntityType, entityName, aclName, direct, match, action string, priority int, external_ids map[string]string, logflag bool, meter, severity string) (*OvnCommand, error) {
 	return c.aclAddImp(entityType, entityName, aclName, direct, match, action, priority, external_ids, logflag, meter, severity)
 }
@@ -817,6 +1481,42 @@ func (c *ovndb) NBGlobalGetOptions() (map[string]string, error) {
 	return c.nbGlobalGetOptionsImp()
 }
 
+func (c *ovndb) NBGlobalGet() (*NBGlobalTableRow, error) {
+	return c.nbGlobalGetImp()
+}
+
+func (c *ovndb) NBGlobalIncNbCfg() (*OvnCommand, error) {
+	return c.nbGlobalIncNbCfgImp()
+}
+
+func (c *ovndb) NBGlobalSetName(name string) (*OvnCommand, error) {
+	return c.nbGlobalSetNameImp(name)
+}
+
+func (c *ovndb) NBGlobalSetExternalIDs(external_ids map[string]string) (*OvnCommand, error) {
+	return c.nbGlobalSetExternalIDsImp(external_ids)
+}
+
+func (c *ovndb) NBGlobalSetMACPrefix(prefix string) (*OvnCommand, error) {
+	return c.nbGlobalSetMACPrefixImp(prefix)
+}
+
+func (c *ovndb) NBGlobalSetSvcMonitorMAC(mac string) (*OvnCommand, error) {
+	return c.nbGlobalSetSvcMonitorMACImp(mac)
+}
+
+func (c *ovndb) NBGlobalSetNorthdProbeInterval(interval int) (*OvnCommand, error) {
+	return c.nbGlobalSetNorthdProbeIntervalImp(interval)
+}
+
+func (c *ovndb) NBGlobalSetUseLogicalDPGroups(enable bool) (*OvnCommand, error) {
+	return c.nbGlobalSetUseLogicalDPGroupsImp(enable)
+}
+
+func (c *ovndb) NBGlobalSetIPSec(enable bool) (*OvnCommand, error) {
+	return c.nbGlobalSetIPSecImp(enable)
+}
+
 func (c *ovndb) SBGlobalSetOptions(options map[string]string) (*OvnCommand, error) {
 	return c.sbGlobalSetOptionsImp(options)
 }
@@ -825,6 +1525,18 @@ func (c *ovndb) SBGlobalGetOptions() (map[string]string, error) {
 	return c.sbGlobalGetOptionsImp()
 }
 
+func (c *ovndb) SBGlobalGet() (*SBGlobalTableRow, error) {
+	return c.sbGlobalGetImp()
+}
+
+func (c *ovndb) SBGlobalSetExternalIDs(external_ids map[string]string) (*OvnCommand, error) {
+	return c.sbGlobalSetExternalIDsImp(external_ids)
+}
+
+func (c *ovndb) SBGlobalSetOption(key string, value string) (*OvnCommand, error) {
+	return c.sbGlobalSetOptionImp(key, value)
+}
+
 func (c *ovndb) PortGroupAdd(group string, ports []string, external_ids map[string]string) (*OvnCommand, error) {
 	return c.pgAddImp(group, ports, external_ids)
 }
@@ -36,6 +36,7 @@ const (
 	TableAddressSet               string = "Address_Set"
 	TablePortGroup                string = "Port_Group"
 	TableLoadBalancer             string = "Load_Balancer"
+	TableLoadBalancerGroup        string = "Load_Balancer_Group"
 	TableACL                      string = "ACL"
 	TableLogicalRouter            string = "Logical_Router"
 	TableQoS                      string = "QoS"
@@ -50,10 +51,26 @@ const (
 	TableDNS                      string = "DNS"
 	TableSSL                      string = "SSL"
 	TableGatewayChassis           string = "Gateway_Chassis"
+	TableBFD                      string = "BFD"
+	TableForwardingGroup          string = "Forwarding_Group"
+	TableCopp                     string = "Copp"
+	TableStaticMACBinding         string = "Static_MAC_Binding"
 	TableChassis                  string = "Chassis"
 	TableEncap                    string = "Encap"
 	TableSBGlobal                 string = "SB_Global"
 	TableChassisPrivate           string = "Chassis_Private"
+	TablePortBinding              string = "Port_Binding"
+	TableDatapathBinding          string = "Datapath_Binding"
+	TableLogicalFlow              string = "Logical_Flow"
+	TableLogicalDPGroup           string = "Logical_DP_Group"
+	TableMACBinding               string = "MAC_Binding"
+	TableFDB                      string = "FDB"
+	TableIGMPGroup                string = "IGMP_Group"
+	TableMulticastGroup           string = "Multicast_Group"
+	TableControllerEvent          string = "Controller_Event"
+	TableRBACRole                 string = "RBAC_Role"
+	TableRBACPermission           string = "RBAC_Permission"
+	TableServiceMonitor           string = "Service_Monitor"
 )
 
 var NBTablesOrder = []string{
@@ -62,11 +79,13 @@ var NBTablesOrder = []string{
 	TableACL,
 	TableDHCPOptions,
 	TableLoadBalancer,
+	TableLoadBalancerGroup,
 	TableQoS,
 	TableMeter,
 	TableMeterBand,
 	TableLogicalRouterPort,
 	TableLogicalRouterStaticRoute,
+	TableBFD,
 	TableLogicalRouterPolicy,
 	TableLogicalSwitchPort,
 	TableNAT,
@@ -74,6 +93,9 @@ var NBTablesOrder = []string{
 	TableDNS,
 	TableSSL,
 	TableGatewayChassis,
+	TableForwardingGroup,
+	TableCopp,
+	TableStaticMACBinding,
 	TablePortGroup,
 	TableLogicalSwitch,
 	TableLogicalRouter,
@@ -84,4 +106,27 @@ var SBTablesOrder = []string{
 	TableChassisPrivate,
 	TableEncap,
 	TableSBGlobal,
+	TablePortBinding,
+	TableDatapathBinding,
+	TableLogicalDPGroup,
+	TableLogicalFlow,
+	TableMACBinding,
+	TableFDB,
+	TableIGMPGroup,
+	TableMulticastGroup,
+	TableControllerEvent,
+	TableRBACPermission,
+	TableRBACRole,
+	TableLoadBalancer,
+	TableMeter,
+	TableMeterBand,
+	TableDNS,
+	TableServiceMonitor,
+}
+
+// SBOptInTables are the south bound tables left out of the default monitor
+// set, as they can hold many rows. List them in Config.TableCols, along with
+// the other tables to monitor, to use their methods.
+var SBOptInTables = []string{
+	TableLogicalFlow,
 }
@@ -18,6 +18,7 @@ package goovn
 
 import (
 	"crypto/tls"
+	"time"
 )
 
 // Config ovn nb and sb db client config
@@ -28,5 +29,8 @@ type Config struct {
 	SignalCB     OVNSignal
 	DisconnectCB OVNDisconnectedCallback // Callback that is called when disconnected, if "Reconnect" is false.
 	Reconnect    bool                    // Automatically reconnect when disconnected
-	TableCols    map[string][]string     // List of tables and their cols to be monitored
+	TableCols    map[string][]string     // List of tables and their cols to be monitored, SBOptInTables only when listed
+	// Chassis lagging behind SB_Global nb_cfg for longer are reported stale through
+	// SignalCB until Close, tracking is disabled when zero. South bound db only.
+	ChassisStaleThreshold time.Duration
 }
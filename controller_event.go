@@ -0,0 +1,120 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Controller_Event types
+const (
+	ControllerEventEmptyLBBackends = "empty_lb_backends"
+)
+
+// ControllerEvent table OVN SB, an event reported by ovn-controller
+type ControllerEvent struct {
+	UUID string
+	Type string
+	Info map[string]string
+	// Chassis is the uuid of the chassis that reported the event
+	Chassis *string
+	SeqNum  int
+}
+
+// EmptyLBBackendsEvent is reported when a load balancer VIP without backends
+// is hit
+type EmptyLBBackendsEvent struct {
+	VIP      string
+	Protocol string
+	// LoadBalancer is the uuid of the NB load balancer
+	LoadBalancer string
+}
+
+// EmptyLBBackends decodes an empty_lb_backends event
+func (ev *ControllerEvent) EmptyLBBackends() (*EmptyLBBackendsEvent, error) {
+	if ev.Type != ControllerEventEmptyLBBackends {
+		return nil, fmt.Errorf("controller event %s is of type %s", ev.UUID, ev.Type)
+	}
+	return &EmptyLBBackendsEvent{
+		VIP:          ev.Info["vip"],
+		Protocol:     ev.Info["protocol"],
+		LoadBalancer: ev.Info["load_balancer"],
+	}, nil
+}
+
+func (odbi *ovndb) controllerEventListImp() ([]*ControllerEvent, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheEvent, ok := odbi.cache[TableControllerEvent]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listEvent := make([]*ControllerEvent, 0, len(cacheEvent))
+	for uuid := range cacheEvent {
+		listEvent = append(listEvent, odbi.rowToControllerEvent(uuid))
+	}
+	return listEvent, nil
+}
+
+// controllerEventAckImp acknowledges the event by deleting its row, which
+// lets ovn-controller report the same event again. It does not read the
+// cache, as OnControllerEvent runs with cachemutex held.
+func (odbi *ovndb) controllerEventAckImp(uuid string) (*OvnCommand, error) {
+	if len(uuid) == 0 {
+		return nil, fmt.Errorf("controller event uuid cannot be empty")
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableControllerEvent,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rowToControllerEvent(uuid string) *ControllerEvent {
+	cacheEvent, ok := odbi.cache[TableControllerEvent][uuid]
+	if !ok {
+		return nil
+	}
+
+	ev := &ControllerEvent{
+		UUID:    uuid,
+		Type:    cacheEvent.Fields["event_type"].(string),
+		Info:    make(map[string]string),
+		Chassis: odbi.optionalStringFieldToPointer(cacheEvent.Fields["chassis"]),
+	}
+	if info, ok := cacheEvent.Fields["event_info"].(libovsdb.OvsMap); ok {
+		for k, v := range info.GoMap {
+			key, keyOk := k.(string)
+			value, valueOk := v.(string)
+			if keyOk && valueOk {
+				ev.Info[key] = value
+			}
+		}
+	}
+	if seqNum, ok := cacheEvent.Fields["seq_num"].(int); ok {
+		ev.SeqNum = seqNum
+	}
+	return ev
+}
@@ -0,0 +1,82 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerEvent(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	info, err := libovsdb.NewOvsMap(map[string]string{
+		"vip":           "10.96.0.10:53",
+		"protocol":      "udp",
+		"load_balancer": NONEXISTENT_UUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := OVNRow{"event_type": ControllerEventEmptyLBBackends, "event_info": info, "seq_num": 1}
+	operations := []libovsdb.Operation{{Op: opInsert, Table: TableControllerEvent, Row: row}}
+	uuids, err := ovndbapi.ExecuteR(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := ovndbapi.ControllerEventList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ev *ControllerEvent
+	for _, e := range events {
+		if e.UUID == uuids[0] {
+			ev = e
+		}
+	}
+	if ev == nil {
+		t.Fatalf("controller event %s not found", uuids[0])
+	}
+	assert.Equal(t, 1, ev.SeqNum)
+	lbEvent, err := ev.EmptyLBBackends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "10.96.0.10:53", lbEvent.VIP)
+	assert.Equal(t, "udp", lbEvent.Protocol)
+	assert.Equal(t, NONEXISTENT_UUID, lbEvent.LoadBalancer)
+
+	cmd, err := ovndbapi.ControllerEventAck(ev.UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err = ovndbapi.ControllerEventList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range events {
+		assert.NotEqual(t, ev.UUID, e.UUID)
+	}
+	_, err = ovndbapi.ControllerEventAck("")
+	assert.Error(t, err)
+}
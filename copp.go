@@ -0,0 +1,282 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Control plane protocols that can be rate limited through Copp
+const (
+	CoppARP         = "arp"
+	CoppARPResolve  = "arp-resolve"
+	CoppDHCPv4Opts  = "dhcpv4-opts"
+	CoppDHCPv6Opts  = "dhcpv6-opts"
+	CoppDNS         = "dns"
+	CoppEventELB    = "event-elb"
+	CoppICMP4Error  = "icmp4-error"
+	CoppICMP6Error  = "icmp6-error"
+	CoppIGMP        = "igmp"
+	CoppNDNA        = "nd-na"
+	CoppNDNS        = "nd-ns"
+	CoppNDNSResolve = "nd-ns-resolve"
+	CoppNDRAOpts    = "nd-ra-opts"
+	CoppTCPReset    = "tcp-reset"
+	CoppBFD         = "bfd"
+	CoppReject      = "reject"
+	CoppSvcMonitor  = "svc-monitor"
+)
+
+var coppProtocols = map[string]bool{
+	CoppARP:         true,
+	CoppARPResolve:  true,
+	CoppDHCPv4Opts:  true,
+	CoppDHCPv6Opts:  true,
+	CoppDNS:         true,
+	CoppEventELB:    true,
+	CoppICMP4Error:  true,
+	CoppICMP6Error:  true,
+	CoppIGMP:        true,
+	CoppNDNA:        true,
+	CoppNDNS:        true,
+	CoppNDNSResolve: true,
+	CoppNDRAOpts:    true,
+	CoppTCPReset:    true,
+	CoppBFD:         true,
+	CoppReject:      true,
+	CoppSvcMonitor:  true,
+}
+
+// Copp ovnnb item, Meters maps a control plane protocol to a meter name
+type Copp struct {
+	UUID       string
+	Name       string
+	Meters     map[string]string
+	ExternalID map[interface{}]interface{}
+}
+
+// validateCoppMeters checks that protocols are known and meters exist
+func (odbi *ovndb) validateCoppMeters(meters map[string]string) error {
+	for proto, meter := range meters {
+		if !coppProtocols[proto] {
+			return fmt.Errorf("unknown copp protocol %s", proto)
+		}
+		row := make(OVNRow)
+		row["name"] = meter
+		if uuid := odbi.getRowUUID(TableMeter, row); len(uuid) == 0 {
+			return fmt.Errorf("meter %s not found", meter)
+		}
+	}
+	return nil
+}
+
+func (odbi *ovndb) coppAddImp(name string, meters map[string]string, external_ids map[string]string) (*OvnCommand, error) {
+	if err := odbi.validateCoppMeters(meters); err != nil {
+		return nil, err
+	}
+
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableCopp, row); len(uuid) > 0 {
+		return nil, ErrorExist
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	oMap, err := libovsdb.NewOvsMap(meters)
+	if err != nil {
+		return nil, err
+	}
+	row["meters"] = oMap
+	if external_ids != nil {
+		oMap, err := libovsdb.NewOvsMap(external_ids)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableCopp,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// coppDelImp deletes the Copp row, references from switches and routers are
+// weak and are cleared by the database
+func (odbi *ovndb) coppDelImp(name string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableCopp, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	condition := libovsdb.NewCondition("name", "==", name)
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableCopp,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// coppSetMetersImp merges meters into the Copp row, protocols not given are kept
+func (odbi *ovndb) coppSetMetersImp(name string, meters map[string]string) (*OvnCommand, error) {
+	if err := odbi.validateCoppMeters(meters); err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableCopp, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.auxKeyValSet(TableCopp, name, "meters", meters)
+}
+
+func (odbi *ovndb) coppDelMetersImp(name string, protocols []string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableCopp, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	kv := make(map[string]*string, len(protocols))
+	for _, proto := range protocols {
+		kv[proto] = nil
+	}
+	return odbi.auxKeyValDel(TableCopp, name, "meters", kv)
+}
+
+func (odbi *ovndb) coppGetImp(name string) (*Copp, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheCopp, ok := odbi.cache[TableCopp]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheCopp {
+		if coppName, ok := drows.Fields["name"].(string); ok && coppName == name {
+			return odbi.rowToCopp(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) coppListImp() ([]*Copp, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheCopp, ok := odbi.cache[TableCopp]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listCopp := make([]*Copp, 0, len(cacheCopp))
+	for uuid := range cacheCopp {
+		listCopp = append(listCopp, odbi.rowToCopp(uuid))
+	}
+	return listCopp, nil
+}
+
+func (odbi *ovndb) rowToCopp(uuid string) *Copp {
+	cacheCopp, ok := odbi.cache[TableCopp][uuid]
+	if !ok {
+		return nil
+	}
+
+	copp := &Copp{
+		UUID:       uuid,
+		Meters:     make(map[string]string),
+		ExternalID: cacheCopp.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if name, ok := cacheCopp.Fields["name"].(string); ok {
+		copp.Name = name
+	}
+	if meters, ok := cacheCopp.Fields["meters"].(libovsdb.OvsMap); ok {
+		for k, v := range meters.GoMap {
+			proto, protoOk := k.(string)
+			meter, meterOk := v.(string)
+			if protoOk && meterOk {
+				copp.Meters[proto] = meter
+			}
+		}
+	}
+	return copp
+}
+
+// coppAttachImp sets the copp column of the logical switch or router named
+// entity in table, an empty copp name clears it
+func (odbi *ovndb) coppAttachImp(table string, entity string, copp string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = entity
+	if uuid := odbi.getRowUUID(table, row); len(uuid) == 0 {
+		return nil, fmt.Errorf("%s %s not found", table, entity)
+	}
+
+	row = make(OVNRow)
+	if len(copp) > 0 {
+		coppRow := make(OVNRow)
+		coppRow["name"] = copp
+		coppUUID := odbi.getRowUUID(TableCopp, coppRow)
+		if len(coppUUID) == 0 {
+			return nil, ErrorNotFound
+		}
+		row["copp"] = stringToGoUUID(coppUUID)
+	} else {
+		emptySet, err := libovsdb.NewOvsSet([]libovsdb.UUID{})
+		if err != nil {
+			return nil, err
+		}
+		row["copp"] = emptySet
+	}
+
+	condition := libovsdb.NewCondition("name", "==", entity)
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: table,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lsSetCoppImp(ls string, copp string) (*OvnCommand, error) {
+	return odbi.coppAttachImp(TableLogicalSwitch, ls, copp)
+}
+
+func (odbi *ovndb) lsClearCoppImp(ls string) (*OvnCommand, error) {
+	return odbi.coppAttachImp(TableLogicalSwitch, ls, "")
+}
+
+func (odbi *ovndb) lrSetCoppImp(lr string, copp string) (*OvnCommand, error) {
+	return odbi.coppAttachImp(TableLogicalRouter, lr, copp)
+}
+
+func (odbi *ovndb) lrClearCoppImp(lr string) (*OvnCommand, error) {
+	return odbi.coppAttachImp(TableLogicalRouter, lr, "")
+}
@@ -0,0 +1,177 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	COPP1     = "copp1"
+	COPP_LS   = "ls-copp"
+	COPP_LR   = "lr-copp"
+	COPP_ARP  = "copp-arp-meter"
+	COPP_ICMP = "copp-icmp-meter"
+)
+
+func TestCopp(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmds := make([]*OvnCommand, 0)
+	for _, meter := range []string{COPP_ARP, COPP_ICMP} {
+		cmd, err := ovndbapi.MeterAdd(meter, "drop", 100, "pktps", nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds = append(cmds, cmd)
+	}
+	cmd, err := ovndbapi.LSAdd(COPP_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LRAdd(COPP_LR, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.CoppAdd(COPP1, map[string]string{"bogus": COPP_ARP}, nil)
+	assert.Error(t, err)
+	_, err = ovndbapi.CoppAdd(COPP1, map[string]string{CoppARP: "copp-no-meter"}, nil)
+	assert.Error(t, err)
+
+	cmd, err = ovndbapi.CoppAdd(COPP1, map[string]string{CoppARP: COPP_ARP}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.CoppSetMeters(COPP1, map[string]string{CoppICMP4Error: COPP_ICMP, CoppICMP6Error: COPP_ICMP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copp, err := ovndbapi.CoppGet(COPP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{CoppARP: COPP_ARP, CoppICMP4Error: COPP_ICMP, CoppICMP6Error: COPP_ICMP}, copp.Meters)
+
+	cmd, err = ovndbapi.CoppDelMeters(COPP1, []string{CoppICMP6Error})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copp, err = ovndbapi.CoppGet(COPP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{CoppARP: COPP_ARP, CoppICMP4Error: COPP_ICMP}, copp.Meters)
+
+	cmd, err = ovndbapi.LSSetCopp(COPP_LS, COPP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.LRSetCopp(COPP_LR, COPP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lss, err := ovndbapi.LSGet(COPP_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, lss[0].Copp) {
+		assert.Equal(t, copp.UUID, *lss[0].Copp)
+	}
+	lrs, err := ovndbapi.LRGet(COPP_LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, lrs[0].Copp) {
+		assert.Equal(t, copp.UUID, *lrs[0].Copp)
+	}
+
+	cmd, err = ovndbapi.LSClearCopp(COPP_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lss, err = ovndbapi.LSGet(COPP_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, lss[0].Copp)
+
+	// the router reference is weak and goes away with the copp
+	cmd, err = ovndbapi.CoppDel(COPP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.CoppGet(COPP1)
+	assert.Equal(t, ErrorNotFound, err)
+	lrs, err = ovndbapi.LRGet(COPP_LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, lrs[0].Copp)
+
+	cmds = make([]*OvnCommand, 0)
+	cmd, err = ovndbapi.LSDel(COPP_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LRDel(COPP_LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.MeterDel(COPP_ARP, COPP_ICMP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// DatapathBinding table OVN SB
+type DatapathBinding struct {
+	UUID      string
+	TunnelKey int
+	// LogicalSwitch and LogicalRouter are the NB uuids the datapath was
+	// created for, from external_ids:logical-switch and logical-router
+	LogicalSwitch string
+	LogicalRouter string
+	// Name is external_ids:name, the NB name of the switch or router
+	Name       string
+	ExternalID map[interface{}]interface{}
+}
+
+func (odbi *ovndb) datapathBindingListImp() ([]*DatapathBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheDatapath, ok := odbi.cache[TableDatapathBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listDatapath := make([]*DatapathBinding, 0, len(cacheDatapath))
+	for uuid := range cacheDatapath {
+		listDatapath = append(listDatapath, odbi.rowToDatapathBinding(uuid))
+	}
+	return listDatapath, nil
+}
+
+// datapathBindingGetByNBUUIDImp returns the datapath created for the NB
+// logical switch or router with uuid nbUUID
+func (odbi *ovndb) datapathBindingGetByNBUUIDImp(nbUUID string) (*DatapathBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheDatapath, ok := odbi.cache[TableDatapathBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheDatapath {
+		extIDs, ok := drows.Fields["external_ids"].(libovsdb.OvsMap)
+		if !ok {
+			continue
+		}
+		if extIDs.GoMap["logical-switch"] == nbUUID || extIDs.GoMap["logical-router"] == nbUUID {
+			return odbi.rowToDatapathBinding(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) datapathBindingGetByTunnelKeyImp(tunnelKey int) (*DatapathBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheDatapath, ok := odbi.cache[TableDatapathBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheDatapath {
+		if key, ok := drows.Fields["tunnel_key"].(int); ok && key == tunnelKey {
+			return odbi.rowToDatapathBinding(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+// datapathBindingResolve returns the uuids of the datapaths with uuid or
+// external_ids:name equal to datapath, caller must hold cachemutex
+func (odbi *ovndb) datapathBindingResolve(datapath string) (map[string]bool, error) {
+	cacheDatapath, ok := odbi.cache[TableDatapathBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	datapaths := make(map[string]bool)
+	for uuid, drows := range cacheDatapath {
+		if uuid == datapath {
+			datapaths[uuid] = true
+			continue
+		}
+		if extIDs, ok := drows.Fields["external_ids"].(libovsdb.OvsMap); ok && extIDs.GoMap["name"] == datapath {
+			datapaths[uuid] = true
+		}
+	}
+	if len(datapaths) == 0 {
+		return nil, ErrorNotFound
+	}
+	return datapaths, nil
+}
+
+func (odbi *ovndb) rowToDatapathBinding(uuid string) *DatapathBinding {
+	cacheDatapath, ok := odbi.cache[TableDatapathBinding][uuid]
+	if !ok {
+		return nil
+	}
+
+	dp := &DatapathBinding{
+		UUID:       uuid,
+		ExternalID: cacheDatapath.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if tunnelKey, ok := cacheDatapath.Fields["tunnel_key"].(int); ok {
+		dp.TunnelKey = tunnelKey
+	}
+	if ls, ok := dp.ExternalID["logical-switch"].(string); ok {
+		dp.LogicalSwitch = ls
+	}
+	if lr, ok := dp.ExternalID["logical-router"].(string); ok {
+		dp.LogicalRouter = lr
+	}
+	if name, ok := dp.ExternalID["name"].(string); ok {
+		dp.Name = name
+	}
+	return dp
+}
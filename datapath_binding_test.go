@@ -0,0 +1,73 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	DP_LS_KEY  = 4101
+	DP_LR_KEY  = 4102
+	DP_LS_UUID = "5b1e7a56-0c2a-4f4e-9d1e-6f4c7e0a0101"
+	DP_LR_UUID = "5b1e7a56-0c2a-4f4e-9d1e-6f4c7e0a0102"
+)
+
+func TestDatapathBinding(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	lsCmd, _ := sbTestDatapathAdd(t, ovndbapi, DP_LS_KEY, map[string]string{"logical-switch": DP_LS_UUID, "name": LSW})
+	lrCmd, _ := sbTestDatapathAdd(t, ovndbapi, DP_LR_KEY, map[string]string{"logical-router": DP_LR_UUID, "name": LR})
+	uuids, err := ovndbapi.ExecuteR(lsCmd, lrCmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dp, err := ovndbapi.DatapathBindingGetByNBUUID(DP_LS_UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DP_LS_KEY, dp.TunnelKey)
+	assert.Equal(t, DP_LS_UUID, dp.LogicalSwitch)
+	assert.Equal(t, LSW, dp.Name)
+
+	dp, err = ovndbapi.DatapathBindingGetByTunnelKey(DP_LR_KEY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DP_LR_UUID, dp.LogicalRouter)
+	assert.Equal(t, "", dp.LogicalSwitch)
+
+	_, err = ovndbapi.DatapathBindingGetByNBUUID(NONEXISTENT_UUID)
+	assert.Equal(t, ErrorNotFound, err)
+	_, err = ovndbapi.DatapathBindingGetByTunnelKey(0)
+	assert.Equal(t, ErrorNotFound, err)
+
+	dps, err := ovndbapi.DatapathBindingList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, len(dps) >= 2)
+
+	err = ovndbapi.Execute(sbTestDatapathDel(t, ovndbapi, uuids[0]), sbTestDatapathDel(t, ovndbapi, uuids[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.DatapathBindingGetByTunnelKey(DP_LS_KEY)
+	assert.Equal(t, ErrorNotFound, err)
+}
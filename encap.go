@@ -18,9 +18,19 @@ package goovn
 
 import (
 	"fmt"
+	"net"
+	"strconv"
+
 	"github.com/ebay/libovsdb"
 )
 
+// Encap options
+const (
+	EncapOptionCsum    string = "csum"
+	EncapOptionDstPort string = "dst_port"
+	EncapOptionLocalIP string = "local_ip"
+)
+
 // Encap table OVN SB
 type Encap struct {
 	UUID        string
@@ -91,3 +101,281 @@ func (odbi *ovndb) rowToEncap(uuid string) (*Encap, error) {
 	}
 	return en, nil
 }
+
+func validateEncapOptions(options map[string]string) error {
+	for k, v := range options {
+		switch k {
+		case EncapOptionCsum:
+			if _, err := strconv.ParseBool(v); err != nil {
+				return ErrorOption
+			}
+		case EncapOptionDstPort:
+			if port, err := strconv.Atoi(v); err != nil || port < 1 || port > 65535 {
+				return ErrorOption
+			}
+		case EncapOptionLocalIP:
+			if net.ParseIP(v) == nil {
+				return ErrorOption
+			}
+		}
+	}
+	return nil
+}
+
+// chassisEncapUUIDs returns the uuid of the chassis named chassisName along
+// with its encap uuids, caller must hold cachemutex
+func (odbi *ovndb) chassisEncapUUIDs(chassisName string) (string, []string) {
+	for uuid, drows := range odbi.cache[TableChassis] {
+		if ch, ok := drows.Fields["name"].(string); ok && ch == chassisName {
+			return uuid, odbi.chassisRowEncaps(drows)
+		}
+	}
+	return "", nil
+}
+
+// chassisRowEncaps returns the encap uuids of a Chassis row
+func (odbi *ovndb) chassisRowEncaps(drows libovsdb.Row) []string {
+	switch enc := drows.Fields["encaps"].(type) {
+	case libovsdb.UUID:
+		return []string{enc.GoUUID}
+	case libovsdb.OvsSet:
+		return odbi.ConvertGoSetToStringArray(enc)
+	}
+	return nil
+}
+
+// chassisEncapUUID returns the uuid of the encap of chassisName with type
+// encapType, caller must hold cachemutex
+func (odbi *ovndb) chassisEncapUUID(chassisName string, encapType string) (string, error) {
+	chUUID, encaps := odbi.chassisEncapUUIDs(chassisName)
+	if len(chUUID) == 0 {
+		return "", ErrorNotFound
+	}
+	for _, uuid := range encaps {
+		if et, ok := odbi.cache[TableEncap][uuid].Fields["type"].(string); ok && et == encapType {
+			return uuid, nil
+		}
+	}
+	return "", ErrorNotFound
+}
+
+// encapAddImp adds an encap of type encapType to an existing chassis
+func (odbi *ovndb) encapAddImp(chassisName string, encapType string, ip string, options map[string]string) (*OvnCommand, error) {
+	if len(encapType) == 0 {
+		return nil, fmt.Errorf("chassis encap type cannot be empty")
+	}
+	if len(ip) == 0 {
+		return nil, fmt.Errorf("chassis ip cannot be empty")
+	}
+	if err := validateEncapOptions(options); err != nil {
+		return nil, err
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	chUUID, _ := odbi.chassisEncapUUIDs(chassisName)
+	if len(chUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	if _, err := odbi.chassisEncapUUID(chassisName, encapType); err == nil {
+		return nil, ErrorExist
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["chassis_name"] = chassisName
+	row["type"] = encapType
+	row["ip"] = ip
+	if len(options) > 0 {
+		oMap, err := libovsdb.NewOvsMap(options)
+		if err != nil {
+			return nil, err
+		}
+		row["options"] = oMap
+	}
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableEncap,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+
+	mutateUUID := []libovsdb.UUID{stringToGoUUID(namedUUID)}
+	mutateSet, err := libovsdb.NewOvsSet(mutateUUID)
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("encaps", opInsert, mutateSet)
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(chUUID))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableChassis,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{insertOp, mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// encapDelImp removes the encap of type encapType from a chassis, the Encap
+// row is garbage collected once unreferenced
+func (odbi *ovndb) encapDelImp(chassisName string, encapType string) (*OvnCommand, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	chUUID, encaps := odbi.chassisEncapUUIDs(chassisName)
+	if len(chUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	encapUUID, err := odbi.chassisEncapUUID(chassisName, encapType)
+	if err != nil {
+		return nil, err
+	}
+	if len(encaps) == 1 {
+		return nil, fmt.Errorf("cannot delete the last encap of chassis %s", chassisName)
+	}
+
+	mutateUUID := []libovsdb.UUID{stringToGoUUID(encapUUID)}
+	mutateSet, err := libovsdb.NewOvsSet(mutateUUID)
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("encaps", opDelete, mutateSet)
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(chUUID))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableChassis,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// encapSetOptionsImp sets the given options of the chassis encap, keeping the others
+func (odbi *ovndb) encapSetOptionsImp(chassisName string, encapType string, options map[string]string) (*OvnCommand, error) {
+	if len(options) == 0 {
+		return nil, fmt.Errorf("options cannot be empty")
+	}
+	if err := validateEncapOptions(options); err != nil {
+		return nil, err
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	encapUUID, err := odbi.chassisEncapUUID(chassisName, encapType)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	delKeys, err := libovsdb.NewOvsSet(keys)
+	if err != nil {
+		return nil, err
+	}
+	newOptions, err := libovsdb.NewOvsMap(options)
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(encapUUID))
+	// map insert does not overwrite existing keys, drop them first
+	mutateOp := libovsdb.Operation{
+		Op:    opMutate,
+		Table: TableEncap,
+		Mutations: []interface{}{
+			libovsdb.NewMutation("options", opDelete, delKeys),
+			libovsdb.NewMutation("options", opInsert, newOptions),
+		},
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) encapDelOptionsImp(chassisName string, encapType string, keys []string) (*OvnCommand, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("keys cannot be empty")
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	encapUUID, err := odbi.chassisEncapUUID(chassisName, encapType)
+	if err != nil {
+		return nil, err
+	}
+
+	delKeys, err := libovsdb.NewOvsSet(keys)
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(encapUUID))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableEncap,
+		Mutations: []interface{}{libovsdb.NewMutation("options", opDelete, delKeys)},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// encapSetIPImp changes the tunnel ip of the chassis encap of type encapType
+func (odbi *ovndb) encapSetIPImp(chassisName string, encapType string, ip string) (*OvnCommand, error) {
+	if net.ParseIP(ip) == nil {
+		return nil, fmt.Errorf("invalid encap ip %s", ip)
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	encapUUID, err := odbi.chassisEncapUUID(chassisName, encapType)
+	if err != nil {
+		return nil, err
+	}
+	if encapIP, ok := odbi.cache[TableEncap][encapUUID].Fields["ip"].(string); ok && encapIP == ip {
+		return nil, ErrorNoChanges
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(encapUUID))
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableEncap,
+		Row:   OVNRow{"ip": ip},
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// chassisGetByEncapIPImp returns the chassis owning an encap with the given tunnel ip
+func (odbi *ovndb) chassisGetByEncapIPImp(ip string) (*Chassis, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheEncap, ok := odbi.cache[TableEncap]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	for uuid, drows := range cacheEncap {
+		if encapIP, ok := drows.Fields["ip"].(string); !ok || encapIP != ip {
+			continue
+		}
+		for chUUID, chRows := range odbi.cache[TableChassis] {
+			for _, encUUID := range odbi.chassisRowEncaps(chRows) {
+				if encUUID == uuid {
+					return odbi.rowToChassis(chUUID)
+				}
+			}
+		}
+	}
+	return nil, ErrorNotFound
+}
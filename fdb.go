@@ -0,0 +1,187 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// FDB table OVN SB
+type FDB struct {
+	UUID    string
+	MAC     string
+	DPKey   int
+	PortKey int
+	// Timestamp is the time in ms the entry was last updated, 0 when the
+	// schema has no timestamp column
+	Timestamp int64
+	// LogicalSwitch and LogicalPort are the names dp_key and port_key
+	// translate to, empty when the datapath or port is unknown
+	LogicalSwitch string
+	LogicalPort   string
+}
+
+// fdbPortKeys returns the tunnel keys of the datapath and of the port binding
+// of logicalPort, caller must hold cachemutex
+func (odbi *ovndb) fdbPortKeys(logicalPort string) (int, int, error) {
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return 0, 0, ErrorSchema
+	}
+	for uuid, drows := range cachePortBinding {
+		if lp, ok := drows.Fields["logical_port"].(string); ok && lp == logicalPort {
+			pb := odbi.rowToPortBinding(uuid)
+			dp := odbi.rowToDatapathBinding(pb.Datapath)
+			if dp == nil {
+				return 0, 0, ErrorNotFound
+			}
+			return dp.TunnelKey, pb.TunnelKey, nil
+		}
+	}
+	return 0, 0, ErrorNotFound
+}
+
+// fdbPortIndexKey identifies a port binding by its datapath and tunnel key
+type fdbPortIndexKey struct {
+	dpUUID  string
+	portKey int
+}
+
+// fdbIndex translates FDB dp_key and port_key to names, built once per list
+type fdbIndex struct {
+	datapaths map[int]string
+	switches  map[int]string
+	ports     map[fdbPortIndexKey]string
+}
+
+// newFDBIndex indexes Datapath_Binding by tunnel key and Port_Binding by
+// datapath and tunnel key, caller must hold cachemutex
+func (odbi *ovndb) newFDBIndex() *fdbIndex {
+	index := &fdbIndex{
+		datapaths: make(map[int]string),
+		switches:  make(map[int]string),
+		ports:     make(map[fdbPortIndexKey]string),
+	}
+	for dpuuid, drows := range odbi.cache[TableDatapathBinding] {
+		if tunnelKey, ok := drows.Fields["tunnel_key"].(int); ok {
+			index.datapaths[tunnelKey] = dpuuid
+			if dp := odbi.rowToDatapathBinding(dpuuid); dp != nil {
+				index.switches[tunnelKey] = dp.Name
+			}
+		}
+	}
+	for _, drows := range odbi.cache[TablePortBinding] {
+		datapath, ok := drows.Fields["datapath"].(libovsdb.UUID)
+		if !ok {
+			continue
+		}
+		if tunnelKey, ok := drows.Fields["tunnel_key"].(int); ok {
+			key := fdbPortIndexKey{dpUUID: datapath.GoUUID, portKey: tunnelKey}
+			index.ports[key], _ = drows.Fields["logical_port"].(string)
+		}
+	}
+	return index
+}
+
+func (odbi *ovndb) fdbListImp() ([]*FDB, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheFDB, ok := odbi.cache[TableFDB]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	index := odbi.newFDBIndex()
+	listFDB := make([]*FDB, 0, len(cacheFDB))
+	for uuid := range cacheFDB {
+		listFDB = append(listFDB, odbi.rowToFDB(uuid, index))
+	}
+	return listFDB, nil
+}
+
+func (odbi *ovndb) fdbListByPortImp(logicalPort string) ([]*FDB, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheFDB, ok := odbi.cache[TableFDB]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	dpKey, portKey, err := odbi.fdbPortKeys(logicalPort)
+	if err != nil {
+		return nil, err
+	}
+
+	index := odbi.newFDBIndex()
+	var listFDB []*FDB
+	for uuid, drows := range cacheFDB {
+		if drows.Fields["dp_key"] == dpKey && drows.Fields["port_key"] == portKey {
+			listFDB = append(listFDB, odbi.rowToFDB(uuid, index))
+		}
+	}
+	return listFDB, nil
+}
+
+// fdbFlushPortImp deletes all FDB entries learnt on logicalPort
+func (odbi *ovndb) fdbFlushPortImp(logicalPort string) (*OvnCommand, error) {
+	entries, err := odbi.fdbListByPortImp(logicalPort)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	operations := make([]libovsdb.Operation, 0, len(entries))
+	for _, entry := range entries {
+		condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(entry.UUID))
+		deleteOp := libovsdb.Operation{
+			Op:    opDelete,
+			Table: TableFDB,
+			Where: []interface{}{condition},
+		}
+		operations = append(operations, deleteOp)
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rowToFDB(uuid string, index *fdbIndex) *FDB {
+	cacheFDB, ok := odbi.cache[TableFDB][uuid]
+	if !ok {
+		return nil
+	}
+
+	fdb := &FDB{
+		UUID:      uuid,
+		MAC:       cacheFDB.Fields["mac"].(string),
+		Timestamp: int64FieldValue(cacheFDB.Fields["timestamp"]),
+	}
+	if dpKey, ok := cacheFDB.Fields["dp_key"].(int); ok {
+		fdb.DPKey = dpKey
+	}
+	if portKey, ok := cacheFDB.Fields["port_key"].(int); ok {
+		fdb.PortKey = portKey
+	}
+
+	// translate the keys through Datapath_Binding and Port_Binding
+	if dpUUID, ok := index.datapaths[fdb.DPKey]; ok {
+		fdb.LogicalSwitch = index.switches[fdb.DPKey]
+		fdb.LogicalPort = index.ports[fdbPortIndexKey{dpUUID: dpUUID, portKey: fdb.PortKey}]
+	}
+	return fdb
+}
@@ -0,0 +1,105 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	FDB_DP_KEY = 4401
+	FDB_LS     = "fdb-ls"
+	FDB_LSP1   = "fdb-lsp1"
+	FDB_LSP2   = "fdb-lsp2"
+)
+
+func sbTestFDBAdd(t *testing.T, ovndbapi Client, mac string, dpKey int, portKey int) *OvnCommand {
+	row := make(OVNRow)
+	row["mac"] = mac
+	row["dp_key"] = dpKey
+	row["port_key"] = portKey
+	insertOp := libovsdb.Operation{
+		Op:    opInsert,
+		Table: TableFDB,
+		Row:   row,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+}
+
+func TestFDB(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	dpCmd, dp := sbTestDatapathAdd(t, ovndbapi, FDB_DP_KEY, map[string]string{"name": FDB_LS})
+	uuids, err := ovndbapi.ExecuteR(dpCmd,
+		sbTestPortBindingAdd(t, ovndbapi, dp, FDB_LSP1, 1, ""),
+		sbTestPortBindingAdd(t, ovndbapi, dp, FDB_LSP2, 2, ""),
+		sbTestFDBAdd(t, ovndbapi, "00:00:00:00:07:01", FDB_DP_KEY, 1),
+		sbTestFDBAdd(t, ovndbapi, "00:00:00:00:07:02", FDB_DP_KEY, 1),
+		sbTestFDBAdd(t, ovndbapi, "00:00:00:00:07:03", FDB_DP_KEY, 2),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpUUID := uuids[0]
+
+	entries, err := ovndbapi.FDBListByPort(FDB_LSP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(entries))
+	for _, entry := range entries {
+		assert.Equal(t, FDB_LS, entry.LogicalSwitch)
+		assert.Equal(t, FDB_LSP1, entry.LogicalPort)
+	}
+	_, err = ovndbapi.FDBListByPort(FAKENOSWITCH)
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err := ovndbapi.FDBFlushPort(FDB_LSP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err = ovndbapi.FDBList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := 0
+	for _, entry := range entries {
+		if entry.DPKey == FDB_DP_KEY {
+			found++
+			assert.Equal(t, FDB_LSP2, entry.LogicalPort)
+		}
+	}
+	assert.Equal(t, 1, found)
+	_, err = ovndbapi.FDBFlushPort(FDB_LSP1)
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err = ovndbapi.FDBFlushPort(FDB_LSP2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, sbTestDatapathDel(t, ovndbapi, dpUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
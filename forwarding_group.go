@@ -0,0 +1,316 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ebay/libovsdb"
+)
+
+// ForwardingGroup ovnnb item
+type ForwardingGroup struct {
+	UUID       string
+	Name       string
+	VIP        string
+	VMAC       string
+	Liveness   bool
+	ChildPort  []string
+	ExternalID map[interface{}]interface{}
+}
+
+func (odbi *ovndb) fwdGroupAddImp(ls string, name string, vip string, vmac string, childPorts []string, liveness bool, external_ids map[string]string) (*OvnCommand, error) {
+	if net.ParseIP(vip) == nil {
+		return nil, fmt.Errorf("invalid vip %s", vip)
+	}
+	if _, err := net.ParseMAC(vmac); err != nil {
+		return nil, fmt.Errorf("invalid vmac %s: %s", vmac, err)
+	}
+	if len(childPorts) == 0 {
+		return nil, fmt.Errorf("forwarding group %s needs at least one child port", name)
+	}
+
+	row := make(OVNRow)
+	row["name"] = ls
+	lsUUID := odbi.getRowUUID(TableLogicalSwitch, row)
+	if len(lsUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	// ovn-northd ignores child ports of other switches
+	lsPorts := odbi.lsPortNames(lsUUID)
+	for _, port := range childPorts {
+		if !lsPorts[port] {
+			return nil, fmt.Errorf("logical switch port %s not found in switch %s", port, ls)
+		}
+	}
+
+	row = make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableForwardingGroup, row); len(uuid) > 0 {
+		return nil, ErrorExist
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row["vip"] = vip
+	row["vmac"] = vmac
+	row["liveness"] = liveness
+	ports, err := libovsdb.NewOvsSet(childPorts)
+	if err != nil {
+		return nil, err
+	}
+	row["child_port"] = ports
+	if external_ids != nil {
+		oMap, err := libovsdb.NewOvsMap(external_ids)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableForwardingGroup,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(namedUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("forwarding_groups", opInsert, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", ls)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalSwitch,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{insertOp, mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// lsPortNames returns the names of the ports of the logical switch lsUUID
+func (odbi *ovndb) lsPortNames(lsUUID string) map[string]bool {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	var uuids []string
+	switch ports := odbi.cache[TableLogicalSwitch][lsUUID].Fields["ports"].(type) {
+	case libovsdb.UUID:
+		uuids = []string{ports.GoUUID}
+	case libovsdb.OvsSet:
+		uuids = odbi.ConvertGoSetToStringArray(ports)
+	}
+	names := make(map[string]bool, len(uuids))
+	for _, uuid := range uuids {
+		if name, ok := odbi.cache[TableLogicalSwitchPort][uuid].Fields["name"].(string); ok {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func (odbi *ovndb) fwdGroupDelImp(name string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	groupUUID := odbi.getRowUUID(TableForwardingGroup, row)
+	if len(groupUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	lsUUID, err := odbi.getRowUUIDContainsUUID(TableLogicalSwitch, "forwarding_groups", groupUUID)
+	if err != nil {
+		return nil, err
+	}
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(groupUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("forwarding_groups", opDelete, mutateSet)
+	mucondition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(lsUUID))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalSwitch,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{mucondition},
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(groupUUID))
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableForwardingGroup,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp, deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// fwdGroupMutatePortImp adds or removes a child port to/from the forwarding group
+func (odbi *ovndb) fwdGroupMutatePortImp(name string, port string, mutator string) (*OvnCommand, error) {
+	group, err := odbi.fwdGroupGetImp(name)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, p := range group.ChildPort {
+		if p == port {
+			found = true
+			break
+		}
+	}
+	switch mutator {
+	case opInsert:
+		if found {
+			return nil, ErrorExist
+		}
+		lsUUID, err := odbi.getRowUUIDContainsUUID(TableLogicalSwitch, "forwarding_groups", group.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if !odbi.lsPortNames(lsUUID)[port] {
+			return nil, fmt.Errorf("logical switch port %s not found in the switch of forwarding group %s", port, name)
+		}
+	case opDelete:
+		if !found {
+			return nil, ErrorNotFound
+		}
+		if len(group.ChildPort) == 1 {
+			return nil, fmt.Errorf("forwarding group %s needs at least one child port", name)
+		}
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]string{port})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("child_port", mutator, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", name)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableForwardingGroup,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) fwdGroupAddPortImp(name string, port string) (*OvnCommand, error) {
+	return odbi.fwdGroupMutatePortImp(name, port, opInsert)
+}
+
+func (odbi *ovndb) fwdGroupDelPortImp(name string, port string) (*OvnCommand, error) {
+	return odbi.fwdGroupMutatePortImp(name, port, opDelete)
+}
+
+func (odbi *ovndb) fwdGroupSetLivenessImp(name string, liveness bool) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableForwardingGroup, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	row = make(OVNRow)
+	row["liveness"] = liveness
+	condition := libovsdb.NewCondition("name", "==", name)
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableForwardingGroup,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) fwdGroupGetImp(name string) (*ForwardingGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGroup, ok := odbi.cache[TableForwardingGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheGroup {
+		if groupName, ok := drows.Fields["name"].(string); ok && groupName == name {
+			return odbi.rowToForwardingGroup(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) fwdGroupListImp(ls string) ([]*ForwardingGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheLogicalSwitch, ok := odbi.cache[TableLogicalSwitch]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for _, drows := range cacheLogicalSwitch {
+		if lsName, ok := drows.Fields["name"].(string); !ok || lsName != ls {
+			continue
+		}
+		var uuids []string
+		switch groups := drows.Fields["forwarding_groups"].(type) {
+		case libovsdb.UUID:
+			uuids = []string{groups.GoUUID}
+		case libovsdb.OvsSet:
+			uuids = odbi.ConvertGoSetToStringArray(groups)
+		}
+		listGroup := make([]*ForwardingGroup, 0, len(uuids))
+		for _, uuid := range uuids {
+			if group := odbi.rowToForwardingGroup(uuid); group != nil {
+				listGroup = append(listGroup, group)
+			}
+		}
+		return listGroup, nil
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) rowToForwardingGroup(uuid string) *ForwardingGroup {
+	cacheGroup, ok := odbi.cache[TableForwardingGroup][uuid]
+	if !ok {
+		return nil
+	}
+
+	group := &ForwardingGroup{
+		UUID:       uuid,
+		Name:       cacheGroup.Fields["name"].(string),
+		VIP:        cacheGroup.Fields["vip"].(string),
+		VMAC:       cacheGroup.Fields["vmac"].(string),
+		ExternalID: cacheGroup.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if liveness, ok := cacheGroup.Fields["liveness"].(bool); ok {
+		group.Liveness = liveness
+	}
+	switch ports := cacheGroup.Fields["child_port"].(type) {
+	case string:
+		group.ChildPort = []string{ports}
+	case libovsdb.OvsSet:
+		group.ChildPort = odbi.ConvertGoSetToStringArray(ports)
+	}
+	return group
+}
@@ -0,0 +1,159 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LS_FWD      = "ls-fwdgroup"
+	LSP_FWD1    = "lsp-fwd1"
+	LSP_FWD2    = "lsp-fwd2"
+	LS_FWD2     = "ls-fwdgroup2"
+	LSP_FWD3    = "lsp-fwd3"
+	FWDGROUP1   = "fwdgroup1"
+	FWDGROUP_IP = "10.10.10.100"
+)
+
+func TestForwardingGroup(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmds := make([]*OvnCommand, 0)
+	cmd, err := ovndbapi.LSAdd(LS_FWD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	for _, lsp := range []string{LSP_FWD1, LSP_FWD2} {
+		cmd, err = ovndbapi.LSPAdd(LS_FWD, lsp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds = append(cmds, cmd)
+	}
+	// a port of another switch can not be a child port
+	cmd, err = ovndbapi.LSAdd(LS_FWD2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LSPAdd(LS_FWD2, LSP_FWD3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.FwdGroupAdd(LS_FWD, FWDGROUP1, "not-an-ip", "00:00:00:00:00:01", []string{LSP_FWD1}, true, nil)
+	assert.Error(t, err)
+	_, err = ovndbapi.FwdGroupAdd(LS_FWD, FWDGROUP1, FWDGROUP_IP, "00:00:00:00:00:01", nil, true, nil)
+	assert.Error(t, err)
+	_, err = ovndbapi.FwdGroupAdd(FAKENOSWITCH, FWDGROUP1, FWDGROUP_IP, "00:00:00:00:00:01", []string{LSP_FWD1}, true, nil)
+	assert.Equal(t, ErrorNotFound, err)
+	_, err = ovndbapi.FwdGroupAdd(LS_FWD, FWDGROUP1, FWDGROUP_IP, "00:00:00:00:00:01", []string{LSP_FWD1, LSP_FWD3}, true, nil)
+	assert.Error(t, err)
+
+	cmd, err = ovndbapi.FwdGroupAdd(LS_FWD, FWDGROUP1, FWDGROUP_IP, "00:00:00:00:00:01", []string{LSP_FWD1}, true, map[string]string{FOO: BAR})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.FwdGroupAdd(LS_FWD, FWDGROUP1, FWDGROUP_IP, "00:00:00:00:00:01", []string{LSP_FWD1}, true, nil)
+	assert.Equal(t, ErrorExist, err)
+
+	groups, err := ovndbapi.FwdGroupList(LS_FWD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, FWDGROUP1, groups[0].Name)
+	assert.Equal(t, FWDGROUP_IP, groups[0].VIP)
+	assert.Equal(t, "00:00:00:00:00:01", groups[0].VMAC)
+	assert.Equal(t, true, groups[0].Liveness)
+	assert.Equal(t, []string{LSP_FWD1}, groups[0].ChildPort)
+	assert.Equal(t, BAR, groups[0].ExternalID[FOO])
+
+	_, err = ovndbapi.FwdGroupAddPort(FWDGROUP1, LSP_FWD3)
+	assert.Error(t, err)
+	cmd, err = ovndbapi.FwdGroupAddPort(FWDGROUP1, LSP_FWD2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.FwdGroupSetLiveness(FWDGROUP1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	group, err := ovndbapi.FwdGroupGet(FWDGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.ElementsMatch(t, []string{LSP_FWD1, LSP_FWD2}, group.ChildPort)
+	assert.Equal(t, false, group.Liveness)
+
+	cmd, err = ovndbapi.FwdGroupDelPort(FWDGROUP1, LSP_FWD1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the last child port can not be removed
+	_, err = ovndbapi.FwdGroupDelPort(FWDGROUP1, LSP_FWD2)
+	assert.Error(t, err)
+
+	cmd, err = ovndbapi.FwdGroupDel(FWDGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.FwdGroupGet(FWDGROUP1)
+	assert.Equal(t, ErrorNotFound, err)
+	groups, err = ovndbapi.FwdGroupList(LS_FWD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(groups))
+
+	cmd, err = ovndbapi.LSDel(LS_FWD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err = ovndbapi.LSDel(LS_FWD2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -22,6 +22,20 @@ import (
 	"github.com/ebay/libovsdb"
 )
 
+// globalRowUUID returns the uuid of the single row of the global table
+func (odbi *ovndb) globalRowUUID(table string) (string, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+	cacheGlobal, ok := odbi.cache[table]
+	if !ok {
+		return "", fmt.Errorf("Table %s not found in cache %v", table, odbi.cache)
+	}
+	for uuid := range cacheGlobal {
+		return uuid, nil
+	}
+	return "", fmt.Errorf("No row found in %s table", table)
+}
+
 func (odbi *ovndb) addGlobalTableRowImp(options map[string]string, table string) (*OvnCommand, error) {
 	namedUUID, err := newRowUUID()
 	if err != nil {
@@ -57,18 +71,7 @@ func (odbi *ovndb) delGlobalTableRowImp(table string) (*OvnCommand, error) {
 		return nil, fmt.Errorf("Invalid table name passed to delete")
 	}
 
-	uuid, err := func() (string, error) {
-		odbi.cachemutex.RLock()
-		defer odbi.cachemutex.RUnlock()
-		cacheGlobal, ok := odbi.cache[table]
-		if !ok {
-			return "", fmt.Errorf("Table %s not found in cache %v", table, odbi.cache)
-		}
-		for uuid, _ := range cacheGlobal {
-			return uuid, nil
-		}
-		return "", fmt.Errorf("No row found in %s table", table)
-	}()
+	uuid, err := odbi.globalRowUUID(table)
 	if err != nil {
 		return nil, err
 	}
@@ -92,18 +95,7 @@ func (odbi *ovndb) globalSetOptionsImp(options map[string]string, table string)
 		return nil, err
 	}
 
-	uuid, err := func() (string, error) {
-		odbi.cachemutex.RLock()
-		defer odbi.cachemutex.RUnlock()
-		cacheGlobal, ok := odbi.cache[table]
-		if !ok {
-			return "", fmt.Errorf("Table %s not found in cache %v", table, odbi.cache)
-		}
-		for uuid, _ := range cacheGlobal {
-			return uuid, nil
-		}
-		return "", fmt.Errorf("No row found in %s table", table)
-	}()
+	uuid, err := odbi.globalRowUUID(table)
 	if err != nil {
 		return nil, err
 	}
@@ -151,3 +143,80 @@ func (odbi *ovndb) globalGetOptionsImp(table string) (map[string]string, error)
 	}
 	return nil, fmt.Errorf("No row found in %s table", table)
 }
+
+// globalMergeMapImp sets the given keys of a map column of the global table
+// row, keeping the other keys
+func (odbi *ovndb) globalMergeMapImp(table string, column string, kv map[string]string) (*OvnCommand, error) {
+	if len(kv) == 0 {
+		return nil, fmt.Errorf("key-value map is nil or empty")
+	}
+	uuid, err := odbi.globalRowUUID(table)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	delKeys, err := libovsdb.NewOvsSet(keys)
+	if err != nil {
+		return nil, err
+	}
+	newMap, err := libovsdb.NewOvsMap(kv)
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	// map insert does not overwrite existing keys, drop them first
+	mutateOp := libovsdb.Operation{
+		Op:    opMutate,
+		Table: table,
+		Mutations: []interface{}{
+			libovsdb.NewMutation(column, opDelete, delKeys),
+			libovsdb.NewMutation(column, opInsert, newMap),
+		},
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// globalUpdateImp updates columns of the global table row
+func (odbi *ovndb) globalUpdateImp(table string, row OVNRow) (*OvnCommand, error) {
+	uuid, err := odbi.globalRowUUID(table)
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: table,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// globalRowCommon holds the columns shared by NB_Global and SB_Global,
+// caller must hold cachemutex
+func (odbi *ovndb) globalRowCommon(fields map[string]interface{}) (options, externalIDs map[interface{}]interface{}, connections []string, ssl string, ipsec bool) {
+	if m, ok := fields["options"].(libovsdb.OvsMap); ok {
+		options = m.GoMap
+	}
+	if m, ok := fields["external_ids"].(libovsdb.OvsMap); ok {
+		externalIDs = m.GoMap
+	}
+	switch conns := fields["connections"].(type) {
+	case libovsdb.UUID:
+		connections = []string{conns.GoUUID}
+	case libovsdb.OvsSet:
+		connections = odbi.ConvertGoSetToStringArray(conns)
+	}
+	if sslUUID := odbi.optionalStringFieldToPointer(fields["ssl"]); sslUUID != nil {
+		ssl = *sslUUID
+	}
+	ipsec, _ = fields["ipsec"].(bool)
+	return
+}
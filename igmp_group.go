@@ -0,0 +1,83 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// IGMPGroup table OVN SB, a multicast group learnt through IGMP/MLD snooping
+type IGMPGroup struct {
+	UUID    string
+	Address string
+	// Datapath and Chassis are uuids, nil once the referenced row is gone
+	Datapath *string
+	Chassis  *string
+	// Ports are uuids of Port_Binding rows
+	Ports []string
+}
+
+// igmpGroupListImp lists the IGMP groups of datapath, given by uuid or name,
+// or all of them when datapath is empty
+func (odbi *ovndb) igmpGroupListImp(datapath string) ([]*IGMPGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGroup, ok := odbi.cache[TableIGMPGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	var datapaths map[string]bool
+	if len(datapath) > 0 {
+		var err error
+		datapaths, err = odbi.datapathBindingResolve(datapath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listGroup := make([]*IGMPGroup, 0, len(cacheGroup))
+	for uuid := range cacheGroup {
+		group := odbi.rowToIGMPGroup(uuid)
+		if datapaths != nil && (group.Datapath == nil || !datapaths[*group.Datapath]) {
+			continue
+		}
+		listGroup = append(listGroup, group)
+	}
+	return listGroup, nil
+}
+
+func (odbi *ovndb) rowToIGMPGroup(uuid string) *IGMPGroup {
+	cacheGroup, ok := odbi.cache[TableIGMPGroup][uuid]
+	if !ok {
+		return nil
+	}
+
+	group := &IGMPGroup{
+		UUID:     uuid,
+		Address:  cacheGroup.Fields["address"].(string),
+		Datapath: odbi.optionalStringFieldToPointer(cacheGroup.Fields["datapath"]),
+		Chassis:  odbi.optionalStringFieldToPointer(cacheGroup.Fields["chassis"]),
+	}
+	switch ports := cacheGroup.Fields["ports"].(type) {
+	case libovsdb.UUID:
+		group.Ports = []string{ports.GoUUID}
+	case libovsdb.OvsSet:
+		group.Ports = odbi.ConvertGoSetToStringArray(ports)
+	}
+	return group
+}
@@ -0,0 +1,163 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// IPsecKeyPrefix is the prefix of the chassis external_ids, or other_config,
+// keys carrying its IPsec configuration
+const IPsecKeyPrefix = "ovn-ipsec-"
+
+// ipsecEncapTypes are the tunnel types OVN can encrypt
+var ipsecEncapTypes = map[string]bool{"geneve": true, "vxlan": true}
+
+// ipsecEncapOptions are the encap options that must agree between chassis
+// for their encrypted tunnels to come up
+var ipsecEncapOptions = []string{EncapOptionDstPort, EncapOptionCsum}
+
+// ChassisIPsecStatus tells if a chassis is ready for IPsec encryption
+type ChassisIPsecStatus struct {
+	Chassis     string
+	ChassisUUID string
+	// Config holds the ovn-ipsec-* keys of the chassis
+	Config map[string]string
+	Ready  bool
+	// Problems describe why the chassis is not ready
+	Problems []string
+}
+
+// nbGlobalSetIPSecImp turns IPsec encryption of tunnel traffic on or off
+func (odbi *ovndb) nbGlobalSetIPSecImp(enable bool) (*OvnCommand, error) {
+	return odbi.globalUpdateImp(TableNBGlobal, OVNRow{"ipsec": enable})
+}
+
+// chassisIPsecStatusListImp reports the IPsec readiness of every chassis: it
+// needs a geneve or vxlan encap with an ip, whose dst_port and csum options
+// match the ones most chassis use for that encap type, and the requiredKeys
+// in its external_ids or other_config, or at least one ovn-ipsec-* key when
+// requiredKeys is empty
+func (odbi *ovndb) chassisIPsecStatusListImp(requiredKeys []string) ([]*ChassisIPsecStatus, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheChassis, ok := odbi.cache[TableChassis]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	// tunnel encaps of each chassis, and how many chassis use each option
+	// value by encap type and option
+	chassisEncaps := make(map[string][]*Encap, len(cacheChassis))
+	optionCounts := make(map[string]map[string]map[string]int)
+	for uuid, drows := range cacheChassis {
+		for _, encapUUID := range odbi.chassisRowEncaps(drows) {
+			encap, err := odbi.rowToEncap(encapUUID)
+			if err != nil || !ipsecEncapTypes[encap.Encaptype] {
+				continue
+			}
+			chassisEncaps[uuid] = append(chassisEncaps[uuid], encap)
+			if _, ok := optionCounts[encap.Encaptype]; !ok {
+				optionCounts[encap.Encaptype] = make(map[string]map[string]int)
+			}
+			for _, option := range ipsecEncapOptions {
+				if _, ok := optionCounts[encap.Encaptype][option]; !ok {
+					optionCounts[encap.Encaptype][option] = make(map[string]int)
+				}
+				value, _ := encap.Options[option].(string)
+				optionCounts[encap.Encaptype][option][value]++
+			}
+		}
+	}
+
+	listStatus := make([]*ChassisIPsecStatus, 0, len(cacheChassis))
+	for uuid := range cacheChassis {
+		ch, err := odbi.rowToChassis(uuid)
+		if err != nil {
+			return nil, err
+		}
+		status := &ChassisIPsecStatus{
+			Chassis:     ch.Name,
+			ChassisUUID: uuid,
+			Config:      make(map[string]string),
+		}
+
+		var tunnel bool
+		for _, encap := range chassisEncaps[uuid] {
+			if len(encap.Ip) == 0 {
+				status.Problems = append(status.Problems, fmt.Sprintf("%s encap has no ip", encap.Encaptype))
+				continue
+			}
+			tunnel = true
+			for _, option := range ipsecEncapOptions {
+				value, _ := encap.Options[option].(string)
+				if common := commonEncapOption(optionCounts[encap.Encaptype][option]); value != common {
+					status.Problems = append(status.Problems, fmt.Sprintf("%s encap %s %q differs from %q of most chassis",
+						encap.Encaptype, option, value, common))
+				}
+			}
+		}
+		if !tunnel {
+			status.Problems = append(status.Problems, "no geneve or vxlan encap")
+		}
+
+		// other_config takes precedence over external_ids, as for capabilities
+		for _, m := range []map[interface{}]interface{}{ch.ExternalID, ch.OtherConfig} {
+			for k, v := range m {
+				key, keyOk := k.(string)
+				value, valueOk := v.(string)
+				if keyOk && valueOk && strings.HasPrefix(key, IPsecKeyPrefix) {
+					status.Config[key] = value
+				}
+			}
+		}
+		if len(requiredKeys) == 0 && len(status.Config) == 0 {
+			status.Problems = append(status.Problems, fmt.Sprintf("no %s* key", IPsecKeyPrefix))
+		}
+		var missing []string
+		for _, key := range requiredKeys {
+			if len(status.Config[key]) == 0 {
+				missing = append(missing, key)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			status.Problems = append(status.Problems, fmt.Sprintf("missing %s", strings.Join(missing, ", ")))
+		}
+
+		status.Ready = len(status.Problems) == 0
+		listStatus = append(listStatus, status)
+	}
+	return listStatus, nil
+}
+
+// commonEncapOption returns the option value used by most chassis, the
+// lowest one on a tie, an empty value meaning the option is unset
+func commonEncapOption(counts map[string]int) string {
+	var common string
+	best := 0
+	for value, count := range counts {
+		if count > best || (count == best && value < common) {
+			common = value
+			best = count
+		}
+	}
+	return common
+}
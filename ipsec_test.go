@@ -0,0 +1,153 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const IPSEC_KEY = IPsecKeyPrefix + "certificate"
+
+func TestNBGlobalSetIPSec(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	ovn := ovndbapi.(*ovndb)
+	cmd, err := ovn.nbGlobalAdd(map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, err = ovndbapi.NBGlobalSetIPSec(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err := ovndbapi.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, row.IPSec)
+
+	cmd, err = ovndbapi.NBGlobalSetIPSec(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row, err = ovndbapi.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, row.IPSec)
+
+	cmd, err = ovn.nbGlobalDel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChassisIPsecStatus(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	cmd, err := ovndbapi.ChassisAdd(CHASSIS_NAME, CHASSIS_HOSTNAME, []string{"geneve"}, IP, map[string]string{IPSEC_KEY: "/etc/ovn/cert.pem"}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.ChassisAdd(CHASSIS2_NAME, CHASSIS2_HOSTNAME, []string{"stt"}, IP2, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listStatus, err := ovndbapi.ChassisIPsecStatusList(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(listStatus))
+	for _, status := range listStatus {
+		switch status.Chassis {
+		case CHASSIS_NAME:
+			assert.True(t, status.Ready)
+			assert.Equal(t, map[string]string{IPSEC_KEY: "/etc/ovn/cert.pem"}, status.Config)
+		case CHASSIS2_NAME:
+			assert.False(t, status.Ready)
+			assert.Equal(t, 2, len(status.Problems))
+		}
+	}
+
+	listStatus, err = ovndbapi.ChassisIPsecStatusList([]string{IPSEC_KEY, IPsecKeyPrefix + "private-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range listStatus {
+		assert.False(t, status.Ready)
+		if status.Chassis == CHASSIS_NAME {
+			assert.Equal(t, []string{"missing " + IPsecKeyPrefix + "private-key"}, status.Problems)
+		}
+	}
+
+	// a geneve dst_port other chassis do not use breaks the tunnels
+	cmd, err = ovndbapi.EncapAdd(CHASSIS2_NAME, "geneve", IP2, map[string]string{EncapOptionDstPort: "6082"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listStatus, err = ovndbapi.ChassisIPsecStatusList(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range listStatus {
+		switch status.Chassis {
+		case CHASSIS_NAME:
+			assert.True(t, status.Ready)
+		case CHASSIS2_NAME:
+			assert.Contains(t, status.Problems, `geneve encap dst_port "6082" differs from "" of most chassis`)
+		}
+	}
+
+	cmd, err = ovndbapi.ChassisDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err = ovndbapi.ChassisDel(CHASSIS2_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -17,6 +17,8 @@
 package goovn
 
 import (
+	"fmt"
+	"net"
 	"strings"
 
 	"github.com/ebay/libovsdb"
@@ -29,6 +31,7 @@ type LoadBalancer struct {
 	VIPs            map[interface{}]interface{}
 	Protocol        string
 	SelectionFields string
+	Options         map[interface{}]interface{}
 	ExternalID      map[interface{}]interface{}
 }
 
@@ -196,6 +199,199 @@ func (odbi *ovndb) lbSetSelectionFieldsImp(name string, selectionFields string)
 	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
 }
 
+// lbSetOptionsImp merges options into the LB options column, e.g. reject,
+// hairpin_snat_ip, skip_snat or affinity_timeout. Keys not given are kept.
+func (odbi *ovndb) lbSetOptionsImp(name string, options map[string]string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableLoadBalancer, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.auxKeyValSet(TableLoadBalancer, name, "options", options)
+}
+
+func (odbi *ovndb) lbGetOptionsImp(name string) (map[string]string, error) {
+	lbs, err := odbi.lbGetImp(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(lbs) == 0 {
+		return nil, ErrorNotFound
+	}
+	if len(lbs) > 1 {
+		return nil, ErrorDuplicateName
+	}
+	options := make(map[string]string)
+	for k, v := range lbs[0].Options {
+		key, keyOk := k.(string)
+		value, valueOk := v.(string)
+		if !keyOk || !valueOk {
+			continue
+		}
+		options[key] = value
+	}
+	return options, nil
+}
+
+// parseLBAddr validates a load balancer VIP or backend, which is either a
+// bare IP or IP:port, with IPv6 addresses in [addr]:port form when a port is
+// given. The port is empty for a bare IP.
+func parseLBAddr(addr string) (net.IP, string, error) {
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip, "", nil
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid load balancer address %q: %v", addr, err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || len(port) == 0 {
+		return nil, "", fmt.Errorf("invalid load balancer address %q", addr)
+	}
+	return ip, port, nil
+}
+
+// normalizeLBAddr returns the canonical form of a load balancer VIP or
+// backend so it matches what ovn-nbctl writes.
+func normalizeLBAddr(addr string) (string, error) {
+	ip, port, err := parseLBAddr(addr)
+	if err != nil {
+		return "", err
+	}
+	return formatLBAddr(ip, port), nil
+}
+
+func formatLBAddr(ip net.IP, port string) string {
+	if len(port) == 0 {
+		return ip.String()
+	}
+	if ip.To4() == nil {
+		return fmt.Sprintf("[%s]:%s", ip.String(), port)
+	}
+	return fmt.Sprintf("%s:%s", ip.String(), port)
+}
+
+// lbVIPBackends normalizes vip and its backends, which like in lb-add must be
+// of the address family of the VIP and have a port only if the VIP has one.
+func (odbi *ovndb) lbVIPBackends(vip string, addrs []string) (string, string, error) {
+	vipIP, vipPort, err := parseLBAddr(vip)
+	if err != nil {
+		return "", "", err
+	}
+	backends := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		ip, port, err := parseLBAddr(addr)
+		if err != nil {
+			return "", "", err
+		}
+		if (ip.To4() == nil) != (vipIP.To4() == nil) {
+			return "", "", fmt.Errorf("backend %q is not of the address family of vip %q", addr, vip)
+		}
+		if (len(port) == 0) != (len(vipPort) == 0) {
+			return "", "", fmt.Errorf("backend %q and vip %q must both have a port or neither", addr, vip)
+		}
+		backends = append(backends, formatLBAddr(ip, port))
+	}
+	return formatLBAddr(vipIP, vipPort), strings.Join(backends, ","), nil
+}
+
+// lbHasVIP returns whether the load balancer named name exists and whether vip
+// is already a key of its vips column.
+func (odbi *ovndb) lbHasVIP(name string, vip string) (bool, bool) {
+	row := make(OVNRow)
+	row["name"] = name
+	lbuuid := odbi.getRowUUID(TableLoadBalancer, row)
+	if len(lbuuid) == 0 {
+		return false, false
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+	vips, ok := odbi.cache[TableLoadBalancer][lbuuid].Fields["vips"].(libovsdb.OvsMap)
+	if !ok {
+		return true, false
+	}
+	_, ok = vips.GoMap[vip]
+	return true, ok
+}
+
+func (odbi *ovndb) lbVIPMutateOp(name string, mutations ...interface{}) *OvnCommand {
+	condition := libovsdb.NewCondition("name", "==", name)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLoadBalancer,
+		Mutations: mutations,
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}
+}
+
+func (odbi *ovndb) lbVIPAddImp(name string, vip string, addrs []string) (*OvnCommand, error) {
+	vip, backends, err := odbi.lbVIPBackends(vip, addrs)
+	if err != nil {
+		return nil, err
+	}
+	found, exist := odbi.lbHasVIP(name, vip)
+	if !found {
+		return nil, ErrorNotFound
+	}
+	if exist {
+		return nil, ErrorExist
+	}
+
+	vipMap, err := libovsdb.NewOvsMap(map[string]string{vip: backends})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("vips", opInsert, vipMap)
+	return odbi.lbVIPMutateOp(name, mutation), nil
+}
+
+func (odbi *ovndb) lbVIPDelImp(name string, vip string) (*OvnCommand, error) {
+	vip, err := normalizeLBAddr(vip)
+	if err != nil {
+		return nil, err
+	}
+	found, exist := odbi.lbHasVIP(name, vip)
+	if !found || !exist {
+		return nil, ErrorNotFound
+	}
+
+	keySet, err := libovsdb.NewOvsSet([]string{vip})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("vips", opDelete, keySet)
+	return odbi.lbVIPMutateOp(name, mutation), nil
+}
+
+// lbVIPSetBackendsImp behaves like lb-add --may-exist: the VIP is added if it
+// is missing, otherwise only its backends are replaced.
+func (odbi *ovndb) lbVIPSetBackendsImp(name string, vip string, addrs []string) (*OvnCommand, error) {
+	vip, backends, err := odbi.lbVIPBackends(vip, addrs)
+	if err != nil {
+		return nil, err
+	}
+	if found, _ := odbi.lbHasVIP(name, vip); !found {
+		return nil, ErrorNotFound
+	}
+
+	// Mutations are applied in order, so the old value is dropped
+	// before the new one is inserted.
+	keySet, err := libovsdb.NewOvsSet([]string{vip})
+	if err != nil {
+		return nil, err
+	}
+	vipMap, err := libovsdb.NewOvsMap(map[string]string{vip: backends})
+	if err != nil {
+		return nil, err
+	}
+	delMutation := libovsdb.NewMutation("vips", opDelete, keySet)
+	insMutation := libovsdb.NewMutation("vips", opInsert, vipMap)
+	return odbi.lbVIPMutateOp(name, delMutation, insMutation), nil
+}
+
 func (odbi *ovndb) rowToLB(uuid string) (*LoadBalancer, error) {
 	cacheLoadBalancer, ok := odbi.cache[TableLoadBalancer][uuid]
 	if !ok {
@@ -213,5 +409,8 @@ func (odbi *ovndb) rowToLB(uuid string) (*LoadBalancer, error) {
 	if fields, ok := cacheLoadBalancer.Fields["selection_fields"].(string); ok {
 		lb.SelectionFields = fields
 	}
+	if options, ok := cacheLoadBalancer.Fields["options"].(libovsdb.OvsMap); ok {
+		lb.Options = options.GoMap
+	}
 	return lb, nil
 }
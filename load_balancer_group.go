@@ -0,0 +1,256 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// LoadBalancerGroup ovnnb item
+type LoadBalancerGroup struct {
+	UUID         string
+	Name         string
+	LoadBalancer []string
+}
+
+func (odbi *ovndb) lbGroupAddImp(name string, lbs []string) (*OvnCommand, error) {
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(OVNRow)
+	row["name"] = name
+
+	if uuid := odbi.getRowUUID(TableLoadBalancerGroup, row); len(uuid) > 0 {
+		return nil, ErrorExist
+	}
+
+	if len(lbs) > 0 {
+		lbUUIDs := make([]libovsdb.UUID, 0, len(lbs))
+		for _, lb := range lbs {
+			lbrow := make(OVNRow)
+			lbrow["name"] = lb
+			lbuuid := odbi.getRowUUID(TableLoadBalancer, lbrow)
+			if len(lbuuid) == 0 {
+				return nil, ErrorNotFound
+			}
+			lbUUIDs = append(lbUUIDs, stringToGoUUID(lbuuid))
+		}
+		lbSet, err := libovsdb.NewOvsSet(lbUUIDs)
+		if err != nil {
+			return nil, err
+		}
+		row["load_balancer"] = lbSet
+	}
+
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableLoadBalancerGroup,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lbGroupDelImp(name string) (*OvnCommand, error) {
+	var operations []libovsdb.Operation
+
+	row := make(OVNRow)
+	row["name"] = name
+	groupUUID := odbi.getRowUUID(TableLoadBalancerGroup, row)
+	if len(groupUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	// Logical switches and routers hold strong references to the group,
+	// drop them first so that the delete does not violate integrity.
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(groupUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("load_balancer_group", opDelete, mutateSet)
+	for _, table := range []string{TableLogicalSwitch, TableLogicalRouter} {
+		uuids, err := odbi.getRowsMatchingUUID(table, "load_balancer_group", groupUUID)
+		if err != nil && err != ErrorNotFound {
+			return nil, err
+		}
+		for _, uuid := range uuids {
+			mucondition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+			mutateOp := libovsdb.Operation{
+				Op:        opMutate,
+				Table:     table,
+				Mutations: []interface{}{mutation},
+				Where:     []interface{}{mucondition},
+			}
+			operations = append(operations, mutateOp)
+		}
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(groupUUID))
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableLoadBalancerGroup,
+		Where: []interface{}{condition},
+	}
+	operations = append(operations, deleteOp)
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// lbGroupMutateLBImp adds or removes the load balancer lb to/from group
+func (odbi *ovndb) lbGroupMutateLBImp(group string, lb string, mutator string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = lb
+	lbuuid := odbi.getRowUUID(TableLoadBalancer, row)
+	if len(lbuuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	row = make(OVNRow)
+	row["name"] = group
+	if uuid := odbi.getRowUUID(TableLoadBalancerGroup, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(lbuuid)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("load_balancer", mutator, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", group)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLoadBalancerGroup,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lbGroupAddLBImp(group string, lb string) (*OvnCommand, error) {
+	return odbi.lbGroupMutateLBImp(group, lb, opInsert)
+}
+
+func (odbi *ovndb) lbGroupDelLBImp(group string, lb string) (*OvnCommand, error) {
+	return odbi.lbGroupMutateLBImp(group, lb, opDelete)
+}
+
+func (odbi *ovndb) lbGroupGetImp(name string) ([]*LoadBalancerGroup, error) {
+	var listGroup []*LoadBalancerGroup
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGroup, ok := odbi.cache[TableLoadBalancerGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheGroup {
+		if groupName, ok := drows.Fields["name"].(string); ok && groupName == name {
+			listGroup = append(listGroup, odbi.rowToLBGroup(uuid))
+		}
+	}
+	return listGroup, nil
+}
+
+func (odbi *ovndb) lbGroupListImp() ([]*LoadBalancerGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGroup, ok := odbi.cache[TableLoadBalancerGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listGroup := make([]*LoadBalancerGroup, 0, len(cacheGroup))
+	for uuid := range cacheGroup {
+		listGroup = append(listGroup, odbi.rowToLBGroup(uuid))
+	}
+	return listGroup, nil
+}
+
+func (odbi *ovndb) rowToLBGroup(uuid string) *LoadBalancerGroup {
+	cacheGroup, ok := odbi.cache[TableLoadBalancerGroup][uuid]
+	if !ok {
+		return nil
+	}
+
+	group := &LoadBalancerGroup{
+		UUID: uuid,
+		Name: cacheGroup.Fields["name"].(string),
+	}
+	if lbs, ok := cacheGroup.Fields["load_balancer"]; ok {
+		switch lbs.(type) {
+		case libovsdb.UUID:
+			group.LoadBalancer = []string{lbs.(libovsdb.UUID).GoUUID}
+		case libovsdb.OvsSet:
+			group.LoadBalancer = odbi.ConvertGoSetToStringArray(lbs.(libovsdb.OvsSet))
+		}
+	}
+	return group
+}
+
+// lbGroupAttachImp adds or removes group to/from the load_balancer_group
+// column of the logical switch or router named entity in table
+func (odbi *ovndb) lbGroupAttachImp(table string, entity string, group string, mutator string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = group
+	groupUUID := odbi.getRowUUID(TableLoadBalancerGroup, row)
+	if len(groupUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	row = make(OVNRow)
+	row["name"] = entity
+	if uuid := odbi.getRowUUID(table, row); len(uuid) == 0 {
+		return nil, fmt.Errorf("%s %s not found", table, entity)
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(groupUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("load_balancer_group", mutator, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", entity)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     table,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lsLBGroupAddImp(ls string, group string) (*OvnCommand, error) {
+	return odbi.lbGroupAttachImp(TableLogicalSwitch, ls, group, opInsert)
+}
+
+func (odbi *ovndb) lsLBGroupDelImp(ls string, group string) (*OvnCommand, error) {
+	return odbi.lbGroupAttachImp(TableLogicalSwitch, ls, group, opDelete)
+}
+
+func (odbi *ovndb) lrLBGroupAddImp(lr string, group string) (*OvnCommand, error) {
+	return odbi.lbGroupAttachImp(TableLogicalRouter, lr, group, opInsert)
+}
+
+func (odbi *ovndb) lrLBGroupDelImp(lr string, group string) (*OvnCommand, error) {
+	return odbi.lbGroupAttachImp(TableLogicalRouter, lr, group, opDelete)
+}
@@ -0,0 +1,188 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LBG_LB   = "lbg-lb"
+	LBGROUP1 = "lbgroup1"
+	LS_LBG   = "ls-lbgroup"
+	LR_LBG   = "lr-lbgroup"
+)
+
+func TestLoadBalancerGroup(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+
+	cmds := make([]*OvnCommand, 0)
+	cmd, err := ovndbapi.LSAdd(LS_LBG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LRAdd(LR_LBG, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LBAdd(LBG_LB, "192.168.0.30:80", "tcp", []string{"10.0.0.31:80"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("Setting LB options")
+	cmd, err = ovndbapi.LBSetOptions(LBG_LB, map[string]string{"reject": "true", "skip_snat": "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LBSetOptions(LBG_LB, map[string]string{"skip_snat": "false"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err := ovndbapi.LBGetOptions(LBG_LB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{"reject": "true", "skip_snat": "false"}, options)
+
+	t.Logf("Adding LB group %s", LBGROUP1)
+	cmd, err = ovndbapi.LBGroupAdd(LBGROUP1, []string{LBG_LB})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.LBGroupAdd(LBGROUP1, nil)
+	assert.Equal(t, ErrorExist, err)
+
+	groups, err := ovndbapi.LBGroupGet(LBGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 1 || len(groups[0].LoadBalancer) != 1 {
+		t.Fatalf("lb group not created %v", groups)
+	}
+	groupUUID := groups[0].UUID
+
+	t.Logf("Attaching LB group to %s and %s", LS_LBG, LR_LBG)
+	cmd, err = ovndbapi.LSLBGroupAdd(LS_LBG, LBGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.LRLBGroupAdd(LR_LBG, LBGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lss, err := ovndbapi.LSGet(LS_LBG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{groupUUID}, lss[0].LBGroups)
+	lrs, err := ovndbapi.LRGet(LR_LBG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{groupUUID}, lrs[0].LBGroups)
+
+	t.Logf("Removing LB from LB group")
+	cmd, err = ovndbapi.LBGroupDelLB(LBGROUP1, LBG_LB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err = ovndbapi.LBGroupGet(LBGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(groups[0].LoadBalancer))
+
+	cmd, err = ovndbapi.LSLBGroupDel(LS_LBG, LBGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lss, err = ovndbapi.LSGet(LS_LBG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(lss[0].LBGroups))
+
+	t.Logf("Deleting LB group still referenced by %s", LR_LBG)
+	cmd, err = ovndbapi.LBGroupDel(LBGROUP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err = ovndbapi.LBGroupList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(groups))
+
+	cmds = make([]*OvnCommand, 0)
+	cmd, err = ovndbapi.LBDel(LBG_LB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LRDel(LR_LBG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LSDel(LS_LBG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
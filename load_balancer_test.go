@@ -97,3 +97,100 @@ func TestLoadBalancer(t *testing.T) {
 	}
 	t.Logf("LB deletion done")
 }
+
+func TestLoadBalancerVIPs(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	ocmd, err := ovndbapi.LBAdd(LB1, "192.168.0.19:80", "tcp", []string{"10.0.0.11:80", "10.0.0.12:80"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatalf("Adding LB OVN failed with err %v", err)
+	}
+
+	t.Logf("Adding IPv4 and IPv6 VIPs to LB")
+	ocmd, err = ovndbapi.LBVIPAdd(LB1, "192.168.0.20:8080", []string{"10.0.0.13:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ocmd2, err := ovndbapi.LBVIPAdd(LB1, "[fd00::1]:80", []string{"[fd00::10]:80", "[fd00::11]:80"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd, ocmd2)
+	if err != nil {
+		t.Fatalf("Adding LB VIPs failed with err %v", err)
+	}
+
+	_, err = ovndbapi.LBVIPAdd(LB1, "192.168.0.20:8080", []string{"10.0.0.14:8080"})
+	if err != ErrorExist {
+		t.Fatalf("expected ErrorExist adding duplicate VIP, got %v", err)
+	}
+	for _, backends := range [][]string{{"[fd00::12]:8081"}, {"10.0.0.14"}} {
+		if _, err = ovndbapi.LBVIPAdd(LB1, "192.168.0.21:8081", backends); err == nil {
+			t.Fatalf("expected error adding VIP with backends %v", backends)
+		}
+	}
+
+	lb, err := ovndbapi.LBGet(LB1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lb) != 1 || len(lb[0].VIPs) != 3 {
+		t.Fatalf("expected 3 VIPs on LB, got %+v", lb)
+	}
+	if lb[0].VIPs["[fd00::1]:80"] != "[fd00::10]:80,[fd00::11]:80" {
+		t.Fatalf("unexpected IPv6 VIP backends: %v", lb[0].VIPs["[fd00::1]:80"])
+	}
+
+	t.Logf("Replacing backends of a VIP")
+	ocmd, err = ovndbapi.LBVIPSetBackends(LB1, "192.168.0.20:8080", []string{"10.0.0.15:8080", "10.0.0.16:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatalf("Setting LB VIP backends failed with err %v", err)
+	}
+	lb, err = ovndbapi.LBGet(LB1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lb[0].VIPs["192.168.0.20:8080"] != "10.0.0.15:8080,10.0.0.16:8080" {
+		t.Fatalf("unexpected VIP backends: %v", lb[0].VIPs["192.168.0.20:8080"])
+	}
+	if lb[0].VIPs["192.168.0.19:80"] != "10.0.0.11:80,10.0.0.12:80" {
+		t.Fatalf("other VIP was modified: %v", lb[0].VIPs["192.168.0.19:80"])
+	}
+
+	t.Logf("Deleting a VIP")
+	ocmd, err = ovndbapi.LBVIPDel(LB1, "[fd00::1]:80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatalf("Deleting LB VIP failed with err %v", err)
+	}
+	lb, err = ovndbapi.LBGet(LB1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lb[0].VIPs) != 2 {
+		t.Fatalf("expected 2 VIPs on LB, got %v", lb[0].VIPs)
+	}
+	_, err = ovndbapi.LBVIPDel(LB1, "[fd00::1]:80")
+	if err != ErrorNotFound {
+		t.Fatalf("expected ErrorNotFound deleting missing VIP, got %v", err)
+	}
+
+	ocmd, err = ovndbapi.LBDel(LB1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(ocmd)
+	if err != nil {
+		t.Fatalf("err executing command:%v", err)
+	}
+}
@@ -0,0 +1,274 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"sort"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Logical flow pipelines
+const (
+	LogicalFlowIngress = "ingress"
+	LogicalFlowEgress  = "egress"
+)
+
+// LogicalFlow table OVN SB
+type LogicalFlow struct {
+	UUID string
+	// Datapath is the uuid of the Datapath_Binding of the flow, nil when the
+	// flow applies to a datapath group
+	Datapath *string
+	// DatapathGroup is the uuid of the Logical_DP_Group of the flow
+	DatapathGroup   *string
+	Pipeline        string
+	TableID         int
+	Priority        int
+	Match           string
+	Actions         string
+	ControllerMeter *string
+	// StageName, StageHint and Source come from external_ids
+	StageName  string
+	StageHint  string
+	Source     string
+	ExternalID map[interface{}]interface{}
+}
+
+// LogicalFlowFilter selects logical flows, zero values match everything
+type LogicalFlowFilter struct {
+	// Datapath is the uuid or the name of a Datapath_Binding
+	Datapath  string
+	Pipeline  string
+	TableID   *int
+	Priority  *int
+	StageName string
+	Source    string
+}
+
+// DatapathLogicalFlows are the logical flows of a datapath, as printed by
+// ovn-sbctl lflow-list
+type DatapathLogicalFlows struct {
+	Datapath *DatapathBinding
+	Flows    []*LogicalFlow
+}
+
+// logicalFlowDatapaths returns the datapath uuids the flow applies to,
+// caller must hold cachemutex
+func (odbi *ovndb) logicalFlowDatapaths(flow *LogicalFlow) []string {
+	if flow.Datapath != nil {
+		return []string{*flow.Datapath}
+	}
+	if flow.DatapathGroup == nil {
+		return nil
+	}
+	return odbi.logicalDPGroupDatapaths(*flow.DatapathGroup)
+}
+
+// logicalDPGroupDatapaths returns the datapath uuids of a Logical_DP_Group,
+// caller must hold cachemutex
+func (odbi *ovndb) logicalDPGroupDatapaths(uuid string) []string {
+	group, ok := odbi.cache[TableLogicalDPGroup][uuid]
+	if !ok {
+		return nil
+	}
+	switch datapaths := group.Fields["datapaths"].(type) {
+	case libovsdb.UUID:
+		return []string{datapaths.GoUUID}
+	case libovsdb.OvsSet:
+		return odbi.ConvertGoSetToStringArray(datapaths)
+	}
+	return nil
+}
+
+// logicalFlowMatch tells if flow passes filter, datapaths is the set of
+// datapath uuids selected by filter.Datapath, caller must hold cachemutex
+func (odbi *ovndb) logicalFlowMatch(flow *LogicalFlow, filter *LogicalFlowFilter, datapaths map[string]bool) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.Pipeline) > 0 && flow.Pipeline != filter.Pipeline {
+		return false
+	}
+	if filter.TableID != nil && flow.TableID != *filter.TableID {
+		return false
+	}
+	if filter.Priority != nil && flow.Priority != *filter.Priority {
+		return false
+	}
+	if len(filter.StageName) > 0 && flow.StageName != filter.StageName {
+		return false
+	}
+	if len(filter.Source) > 0 && flow.Source != filter.Source {
+		return false
+	}
+	if len(filter.Datapath) > 0 {
+		for _, dp := range odbi.logicalFlowDatapaths(flow) {
+			if datapaths[dp] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// logicalFlowFilterDatapaths resolves filter.Datapath to datapath uuids,
+// caller must hold cachemutex
+func (odbi *ovndb) logicalFlowFilterDatapaths(filter *LogicalFlowFilter) (map[string]bool, error) {
+	if filter == nil || len(filter.Datapath) == 0 {
+		return map[string]bool{}, nil
+	}
+	return odbi.datapathBindingResolve(filter.Datapath)
+}
+
+// sortLogicalFlows orders flows the way ovn-sbctl lflow-list does: ingress
+// before egress, then by table, by decreasing priority, by match and actions
+func sortLogicalFlows(flows []*LogicalFlow) {
+	sort.Slice(flows, func(i, j int) bool {
+		a, b := flows[i], flows[j]
+		if a.Pipeline != b.Pipeline {
+			return a.Pipeline == LogicalFlowIngress
+		}
+		if a.TableID != b.TableID {
+			return a.TableID < b.TableID
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if a.Match != b.Match {
+			return a.Match < b.Match
+		}
+		return a.Actions < b.Actions
+	})
+}
+
+// logicalFlowListImp returns the flows passing filter in lflow-list order
+func (odbi *ovndb) logicalFlowListImp(filter *LogicalFlowFilter) ([]*LogicalFlow, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheFlow, ok := odbi.cache[TableLogicalFlow]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	datapaths, err := odbi.logicalFlowFilterDatapaths(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var listFlow []*LogicalFlow
+	for uuid := range cacheFlow {
+		flow := odbi.rowToLogicalFlow(uuid)
+		if odbi.logicalFlowMatch(flow, filter, datapaths) {
+			listFlow = append(listFlow, flow)
+		}
+	}
+	sortLogicalFlows(listFlow)
+	return listFlow, nil
+}
+
+// logicalFlowListByDatapathImp returns the flows passing filter grouped by
+// datapath, datapaths ordered by name then uuid. A flow of a datapath group
+// shows up under every datapath of the group.
+func (odbi *ovndb) logicalFlowListByDatapathImp(filter *LogicalFlowFilter) ([]*DatapathLogicalFlows, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheFlow, ok := odbi.cache[TableLogicalFlow]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	if _, ok := odbi.cache[TableDatapathBinding]; !ok {
+		return nil, ErrorSchema
+	}
+	datapaths, err := odbi.logicalFlowFilterDatapaths(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	byDatapath := make(map[string]*DatapathLogicalFlows)
+	for uuid := range cacheFlow {
+		flow := odbi.rowToLogicalFlow(uuid)
+		if !odbi.logicalFlowMatch(flow, filter, datapaths) {
+			continue
+		}
+		for _, dp := range odbi.logicalFlowDatapaths(flow) {
+			if len(datapaths) > 0 && !datapaths[dp] {
+				continue
+			}
+			group, ok := byDatapath[dp]
+			if !ok {
+				datapath := odbi.rowToDatapathBinding(dp)
+				if datapath == nil {
+					continue
+				}
+				group = &DatapathLogicalFlows{Datapath: datapath}
+				byDatapath[dp] = group
+			}
+			group.Flows = append(group.Flows, flow)
+		}
+	}
+
+	listGroup := make([]*DatapathLogicalFlows, 0, len(byDatapath))
+	for _, group := range byDatapath {
+		sortLogicalFlows(group.Flows)
+		listGroup = append(listGroup, group)
+	}
+	sort.Slice(listGroup, func(i, j int) bool {
+		a, b := listGroup[i].Datapath, listGroup[j].Datapath
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.UUID < b.UUID
+	})
+	return listGroup, nil
+}
+
+func (odbi *ovndb) rowToLogicalFlow(uuid string) *LogicalFlow {
+	cacheFlow, ok := odbi.cache[TableLogicalFlow][uuid]
+	if !ok {
+		return nil
+	}
+
+	flow := &LogicalFlow{
+		UUID:            uuid,
+		Datapath:        odbi.optionalStringFieldToPointer(cacheFlow.Fields["logical_datapath"]),
+		DatapathGroup:   odbi.optionalStringFieldToPointer(cacheFlow.Fields["logical_dp_group"]),
+		Pipeline:        cacheFlow.Fields["pipeline"].(string),
+		Match:           cacheFlow.Fields["match"].(string),
+		Actions:         cacheFlow.Fields["actions"].(string),
+		ControllerMeter: odbi.optionalStringFieldToPointer(cacheFlow.Fields["controller_meter"]),
+		ExternalID:      cacheFlow.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if tableID, ok := cacheFlow.Fields["table_id"].(int); ok {
+		flow.TableID = tableID
+	}
+	if priority, ok := cacheFlow.Fields["priority"].(int); ok {
+		flow.Priority = priority
+	}
+	if stageName, ok := flow.ExternalID["stage-name"].(string); ok {
+		flow.StageName = stageName
+	}
+	if stageHint, ok := flow.ExternalID["stage-hint"].(string); ok {
+		flow.StageHint = stageHint
+	}
+	if source, ok := flow.ExternalID["source"].(string); ok {
+		flow.Source = source
+	}
+	return flow
+}
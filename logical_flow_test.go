@@ -0,0 +1,119 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LFLOW_DP_KEY = 4201
+	LFLOW_DP     = "lflow-ls"
+)
+
+func sbTestLogicalFlowAdd(t *testing.T, ovndbapi Client, datapath string, pipeline string, tableID int, priority int, match string, stageName string) *OvnCommand {
+	row := make(OVNRow)
+	row["logical_datapath"] = stringToGoUUID(datapath)
+	row["pipeline"] = pipeline
+	row["table_id"] = tableID
+	row["priority"] = priority
+	row["match"] = match
+	row["actions"] = "next;"
+	oMap, err := libovsdb.NewOvsMap(map[string]string{"stage-name": stageName, "source": "northd.c:1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row["external_ids"] = oMap
+	insertOp := libovsdb.Operation{
+		Op:    opInsert,
+		Table: TableLogicalFlow,
+		Row:   row,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+}
+
+func TestLogicalFlow(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	dpCmd, dp := sbTestDatapathAdd(t, ovndbapi, LFLOW_DP_KEY, map[string]string{"name": LFLOW_DP})
+	uuids, err := ovndbapi.ExecuteR(dpCmd,
+		sbTestLogicalFlowAdd(t, ovndbapi, dp, LogicalFlowEgress, 0, 100, "1", "ls_out_pre_lb"),
+		sbTestLogicalFlowAdd(t, ovndbapi, dp, LogicalFlowIngress, 1, 50, "1", "ls_in_port_sec_ip"),
+		sbTestLogicalFlowAdd(t, ovndbapi, dp, LogicalFlowIngress, 1, 90, "ip4", "ls_in_port_sec_ip"),
+		sbTestLogicalFlowAdd(t, ovndbapi, dp, LogicalFlowIngress, 0, 100, "eth.src[40]", "ls_in_port_sec_l2"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpUUID := uuids[0]
+
+	flows, err := ovndbapi.LogicalFlowList(&LogicalFlowFilter{Datapath: LFLOW_DP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 4, len(flows)) {
+		assert.Equal(t, "ls_in_port_sec_l2", flows[0].StageName)
+		assert.Equal(t, 90, flows[1].Priority)
+		assert.Equal(t, 50, flows[2].Priority)
+		assert.Equal(t, LogicalFlowEgress, flows[3].Pipeline)
+		assert.Equal(t, "northd.c:1", flows[3].Source)
+	}
+
+	tableID := 1
+	flows, err = ovndbapi.LogicalFlowList(&LogicalFlowFilter{Datapath: dpUUID, Pipeline: LogicalFlowIngress, TableID: &tableID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(flows))
+	priority := 100
+	flows, err = ovndbapi.LogicalFlowList(&LogicalFlowFilter{Datapath: dpUUID, Priority: &priority, StageName: "ls_out_pre_lb"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(flows))
+
+	groups, err := ovndbapi.LogicalFlowListByDatapath(&LogicalFlowFilter{Datapath: LFLOW_DP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(groups)) {
+		assert.Equal(t, LFLOW_DP_KEY, groups[0].Datapath.TunnelKey)
+		assert.Equal(t, 4, len(groups[0].Flows))
+	}
+	_, err = ovndbapi.LogicalFlowList(&LogicalFlowFilter{Datapath: FAKENOSWITCH})
+	assert.Equal(t, ErrorNotFound, err)
+
+	// logical flows reference the datapath strongly, drop them first
+	var cmds []*OvnCommand
+	for _, flow := range groups[0].Flows {
+		condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(flow.UUID))
+		deleteOp := libovsdb.Operation{
+			Op:    opDelete,
+			Table: TableLogicalFlow,
+			Where: []interface{}{condition},
+		}
+		cmds = append(cmds, &OvnCommand{[]libovsdb.Operation{deleteOp}, ovndbapi.(*ovndb), make([][]map[string]interface{}, 1)})
+	}
+	cmds = append(cmds, sbTestDatapathDel(t, ovndbapi, dpUUID))
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -18,6 +18,9 @@ package goovn
 
 import (
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/ebay/libovsdb"
 )
@@ -32,12 +35,55 @@ type LogicalRouter struct {
 	StaticRoutes []string
 	NAT          []string
 	LoadBalancer []string
+	LBGroups     []string
+	Copp         *string
 	Policies     []string
 
 	Options    map[interface{}]interface{}
 	ExternalID map[interface{}]interface{}
 }
 
+// Logical_Router options understood by ovn-northd
+const (
+	// LROptionChassis pins the router to a chassis, making it a gateway router
+	LROptionChassis                   = "chassis"
+	LROptionDynamicNeighRouters       = "dynamic_neigh_routers"
+	LROptionAlwaysLearnFromARPRequest = "always_learn_from_arp_request"
+	LROptionLBForceSNATIP             = "lb_force_snat_ip"
+	LROptionSNATCTZone                = "snat-ct-zone"
+	LROptionMACBindingAgeThreshold    = "mac_binding_age_threshold"
+	LROptionMcastRelay                = "mcast_relay"
+)
+
+func validateLROptions(options map[string]string) error {
+	for k, v := range options {
+		switch k {
+		case LROptionChassis:
+			if len(v) == 0 {
+				return ErrorOption
+			}
+		case LROptionDynamicNeighRouters, LROptionAlwaysLearnFromARPRequest, LROptionMcastRelay:
+			if _, err := strconv.ParseBool(v); err != nil {
+				return ErrorOption
+			}
+		case LROptionSNATCTZone, LROptionMACBindingAgeThreshold:
+			if n, err := strconv.Atoi(v); err != nil || n < 0 {
+				return ErrorOption
+			}
+		case LROptionLBForceSNATIP:
+			if v == "router_ip" {
+				continue
+			}
+			for _, ip := range strings.Fields(v) {
+				if net.ParseIP(ip) == nil {
+					return ErrorOption
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (odbi *ovndb) lrAddImp(name string, external_ids map[string]string) (*OvnCommand, error) {
 	namedUUID, err := newRowUUID()
 	if err != nil {
@@ -81,6 +127,82 @@ func (odbi *ovndb) lrDelImp(name string) (*OvnCommand, error) {
 	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
 }
 
+// lrSetOptionsImp merges options into the LR options column, keys not given
+// are kept as they are
+func (odbi *ovndb) lrSetOptionsImp(name string, options map[string]string) (*OvnCommand, error) {
+	if err := validateLROptions(options); err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableLogicalRouter, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.auxKeyValSet(TableLogicalRouter, name, "options", options)
+}
+
+func (odbi *ovndb) lrDelOptionsImp(name string, keys []string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableLogicalRouter, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	kv := make(map[string]*string, len(keys))
+	for _, k := range keys {
+		kv[k] = nil
+	}
+	return odbi.auxKeyValDel(TableLogicalRouter, name, "options", kv)
+}
+
+func (odbi *ovndb) lrGetOptionsImp(name string) (map[string]string, error) {
+	lrs, err := odbi.lrGetImp(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(lrs) == 0 {
+		return nil, ErrorNotFound
+	}
+	if len(lrs) > 1 {
+		return nil, ErrorDuplicateName
+	}
+	options := make(map[string]string)
+	for k, v := range lrs[0].Options {
+		key, keyOk := k.(string)
+		value, valueOk := v.(string)
+		if !keyOk || !valueOk {
+			continue
+		}
+		options[key] = value
+	}
+	return options, nil
+}
+
+// lrSetMulticastRelayImp enables or disables multicast routing between the
+// switches attached to the LR
+func (odbi *ovndb) lrSetMulticastRelayImp(name string, enabled bool) (*OvnCommand, error) {
+	return odbi.lrSetOptionsImp(name, map[string]string{LROptionMcastRelay: strconv.FormatBool(enabled)})
+}
+
+func (odbi *ovndb) lrSetEnabledImp(name string, enabled bool) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = name
+	if uuid := odbi.getRowUUID(TableLogicalRouter, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	row = make(OVNRow)
+	row["enabled"] = enabled
+	condition := libovsdb.NewCondition("name", "==", name)
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableLogicalRouter,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
 func (odbi *ovndb) lrGetImp(name string) ([]*LogicalRouter, error) {
 	var lrList []*LogicalRouter
 
@@ -133,6 +255,19 @@ func (odbi *ovndb) rowToLogicalRouter(uuid string) *LogicalRouter {
 		}
 	}
 
+	if lbGroups, ok := cacheLogicalRouter.Fields["load_balancer_group"]; ok {
+		switch lbGroups.(type) {
+		case libovsdb.UUID:
+			lr.LBGroups = []string{lbGroups.(libovsdb.UUID).GoUUID}
+		case libovsdb.OvsSet:
+			lr.LBGroups = odbi.ConvertGoSetToStringArray(lbGroups.(libovsdb.OvsSet))
+		}
+	}
+
+	if copp, ok := cacheLogicalRouter.Fields["copp"]; ok {
+		lr.Copp = odbi.optionalStringFieldToPointer(copp)
+	}
+
 	if ports, ok := cacheLogicalRouter.Fields["ports"]; ok {
 		switch ports.(type) {
 		case libovsdb.UUID:
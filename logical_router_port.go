@@ -18,6 +18,8 @@ package goovn
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/ebay/libovsdb"
 )
@@ -31,6 +33,7 @@ type LogicalRouterPort struct {
 	MAC            string
 	Enabled        bool
 	IPv6RAConfigs  map[interface{}]interface{}
+	IPv6Prefix     []string
 	Options        map[interface{}]interface{}
 	Peer           string
 	ExternalID     map[interface{}]interface{}
@@ -130,6 +133,156 @@ func (odbi *ovndb) lrpDelImp(lr, lrp string) (*OvnCommand, error) {
 	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
 }
 
+func (odbi *ovndb) lrpUpdateImp(lrp string, row OVNRow) (*OvnCommand, error) {
+	lrpRow := make(OVNRow)
+	lrpRow["name"] = lrp
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, lrpRow); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	condition := libovsdb.NewCondition("name", "==", lrp)
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableLogicalRouterPort,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) lrpSetEnabledImp(lrp string, enabled bool) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["enabled"] = enabled
+	return odbi.lrpUpdateImp(lrp, row)
+}
+
+func (odbi *ovndb) lrpSetMACImp(lrp string, mac string) (*OvnCommand, error) {
+	if _, err := net.ParseMAC(mac); err != nil {
+		return nil, fmt.Errorf("invalid mac %q: %v", mac, err)
+	}
+	row := make(OVNRow)
+	row["mac"] = mac
+	return odbi.lrpUpdateImp(lrp, row)
+}
+
+func (odbi *ovndb) lrpSetNetworksImp(lrp string, networks []string) (*OvnCommand, error) {
+	// networks has min 1 in the schema
+	if len(networks) == 0 {
+		return nil, fmt.Errorf("at least one network is required")
+	}
+	for _, network := range networks {
+		if _, _, err := net.ParseCIDR(network); err != nil {
+			return nil, fmt.Errorf("invalid network %q: %v", network, err)
+		}
+	}
+	networkSet, err := libovsdb.NewOvsSet(networks)
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["networks"] = networkSet
+	return odbi.lrpUpdateImp(lrp, row)
+}
+
+// lrpSetOptionsImp merges options into the LRP options column, e.g.
+// redirect-chassis, gateway_mtu or reside-on-redirect-chassis
+func (odbi *ovndb) lrpSetOptionsImp(lrp string, options map[string]string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = lrp
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.auxKeyValSet(TableLogicalRouterPort, lrp, "options", options)
+}
+
+func (odbi *ovndb) lrpDelOptionsImp(lrp string, keys []string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = lrp
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	kv := make(map[string]*string, len(keys))
+	for _, k := range keys {
+		kv[k] = nil
+	}
+	return odbi.auxKeyValDel(TableLogicalRouterPort, lrp, "options", kv)
+}
+
+// lrpSetIPv6RAConfigsImp merges configs into ipv6_ra_configs after checking
+// the values of the keys OVN interprets
+func (odbi *ovndb) lrpSetIPv6RAConfigsImp(lrp string, configs map[string]string) (*OvnCommand, error) {
+	for k, v := range configs {
+		switch k {
+		case "address_mode":
+			switch v {
+			case "slaac", "dhcpv6_stateful", "dhcpv6_stateless":
+			default:
+				return nil, ErrorOption
+			}
+		case "send_periodic":
+			if _, err := strconv.ParseBool(v); err != nil {
+				return nil, ErrorOption
+			}
+		case "mtu", "max_interval", "min_interval":
+			if _, err := strconv.Atoi(v); err != nil {
+				return nil, ErrorOption
+			}
+		}
+	}
+	row := make(OVNRow)
+	row["name"] = lrp
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.auxKeyValSet(TableLogicalRouterPort, lrp, "ipv6_ra_configs", configs)
+}
+
+func (odbi *ovndb) lrpDelIPv6RAConfigsImp(lrp string, keys []string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = lrp
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	kv := make(map[string]*string, len(keys))
+	for _, k := range keys {
+		kv[k] = nil
+	}
+	return odbi.auxKeyValDel(TableLogicalRouterPort, lrp, "ipv6_ra_configs", kv)
+}
+
+// Get lrp by name
+func (odbi *ovndb) lrpGetImp(lrp string) (*LogicalRouterPort, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheLogicalRouterPort, ok := odbi.cache[TableLogicalRouterPort]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cacheLogicalRouterPort {
+		if rlrp, ok := drows.Fields["name"].(string); ok && rlrp == lrp {
+			return odbi.rowToLogicalRouterPort(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+// lrpSetRouteTableImp binds lrp to the static routes of routeTable through
+// options:route_table, an empty routeTable binds it back to the main table
+func (odbi *ovndb) lrpSetRouteTableImp(lrp string, routeTable string) (*OvnCommand, error) {
+	row := make(OVNRow)
+	row["name"] = lrp
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	if len(routeTable) == 0 {
+		return odbi.auxKeyValDel(TableLogicalRouterPort, lrp, "options", map[string]*string{"route_table": nil})
+	}
+	return odbi.auxKeyValSet(TableLogicalRouterPort, lrp, "options", map[string]string{"route_table": routeTable})
+}
+
 func (odbi *ovndb) rowToLogicalRouterPort(uuid string) *LogicalRouterPort {
 	lrp := &LogicalRouterPort{
 		UUID:       uuid,
@@ -164,6 +317,15 @@ func (odbi *ovndb) rowToLogicalRouterPort(uuid string) *LogicalRouterPort {
 		}
 	}
 
+	if ipv6Prefix, ok := odbi.cache[TableLogicalRouterPort][uuid].Fields["ipv6_prefix"]; ok {
+		switch ipv6Prefix.(type) {
+		case string:
+			lrp.IPv6Prefix = []string{ipv6Prefix.(string)}
+		case libovsdb.OvsSet:
+			lrp.IPv6Prefix = odbi.ConvertGoSetToStringArray(ipv6Prefix.(libovsdb.OvsSet))
+		}
+	}
+
 	gateway_chassis := odbi.cache[TableLogicalRouterPort][uuid].Fields["gateway_chassis"]
 	switch gateway_chassis.(type) {
 	case string:
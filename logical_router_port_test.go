@@ -98,3 +98,113 @@ func TestLogicalRouterPort(t *testing.T) {
 		assert.EqualError(t, ErrorNotFound, err.Error())
 	}
 }
+
+func TestLogicalRouterPortSetters(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmd, err := ovndbapi.LRAdd(LR4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRPAdd(LR4, LRP, "54:54:54:54:54:54", []string{"192.168.0.1/24"}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.LRPGet(FAKENOROUTER)
+	assert.Equal(t, ErrorNotFound, err)
+	_, err = ovndbapi.LRPSetMAC(LRP, "not-a-mac")
+	assert.Error(t, err)
+	_, err = ovndbapi.LRPSetIPv6RAConfigs(LRP, map[string]string{"address_mode": "bogus"})
+	assert.Equal(t, ErrorOption, err)
+
+	cmds := make([]*OvnCommand, 0)
+	cmd, err = ovndbapi.LRPSetEnabled(LRP, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LRPSetMAC(LRP, "54:54:54:54:54:55")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.LRPSetNetworks(LRP, []string{"192.168.0.1/24", "fd00:10::1/64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// map columns are merged against the cache, so they are set one at a time
+	cmd, err = ovndbapi.LRPSetOptions(LRP, map[string]string{"gateway_mtu": "1400", "reside-on-redirect-chassis": "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRPSetIPv6RAConfigs(LRP, map[string]string{"address_mode": "slaac", "send_periodic": "true", "mtu": "1400"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lrp, err := ovndbapi.LRPGet(LRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, false, lrp.Enabled)
+	assert.Equal(t, "54:54:54:54:54:55", lrp.MAC)
+	assert.ElementsMatch(t, []string{"192.168.0.1/24", "fd00:10::1/64"}, lrp.Networks)
+	assert.Equal(t, "1400", lrp.Options["gateway_mtu"])
+	assert.Equal(t, "slaac", lrp.IPv6RAConfigs["address_mode"])
+	assert.Equal(t, "true", lrp.IPv6RAConfigs["send_periodic"])
+
+	cmd, err = ovndbapi.LRPDelOptions(LRP, []string{"gateway_mtu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.LRPDelIPv6RAConfigs(LRP, []string{"send_periodic", "mtu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd3, err := ovndbapi.LRPSetEnabled(LRP, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2, cmd3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrp, err = ovndbapi.LRPGet(LRP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, true, lrp.Enabled)
+	assert.Equal(t, map[interface{}]interface{}{"reside-on-redirect-chassis": "true"}, lrp.Options)
+	assert.Equal(t, map[interface{}]interface{}{"address_mode": "slaac"}, lrp.IPv6RAConfigs)
+
+	cmd, err = ovndbapi.LRDel(LR4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
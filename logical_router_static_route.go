@@ -29,9 +29,35 @@ type LogicalRouterStaticRoute struct {
 	Nexthop    string
 	OutputPort *string
 	Policy     *string
-	ExternalID map[interface{}]interface{}
+	BFD        *string
+	RouteTable string
+	// SelectionFields used for ECMP hashing, e.g. ip_src, ip_dst, tp_src
+	SelectionFields []string
+	Options         map[interface{}]interface{}
+	ExternalID      map[interface{}]interface{}
 }
 
+// LRSRSpec describes a static route for LRSRAddSpec and LRSRDelSpec.
+// An empty RouteTable is the main routing table of the router.
+type LRSRSpec struct {
+	IPPrefix        string
+	Nexthop         string
+	OutputPort      *string
+	Policy          *string
+	RouteTable      string
+	BFD             *string
+	SelectionFields []string
+	Options         map[string]string
+	ExternalID      map[string]string
+}
+
+const (
+	// LRSRPolicyDstIP is the default static route policy
+	LRSRPolicyDstIP = "dst-ip"
+	// LRSRPolicySrcIP routes on the source ip of the packet
+	LRSRPolicySrcIP = "src-ip"
+)
+
 func (odbi *ovndb) lrsrAddImp(lr string, ip_prefix string, nexthop string, output_port *string, policy *string, external_ids map[string]string) (*OvnCommand, error) {
 	namedUUID, err := newRowUUID()
 	if err != nil {
@@ -163,6 +189,220 @@ func (odbi *ovndb) lrsrDelByUUIDImp(lr, uuid string) (*OvnCommand, error) {
 	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
 }
 
+// lrsrMatchSpec returns the static routes of lr with the prefix and route
+// table of spec, further filtered by policy, nexthop and output port when set.
+// Caller must hold the cache lock.
+func (odbi *ovndb) lrsrMatchSpec(lr string, spec *LRSRSpec) ([]string, error) {
+	var lrsrs []string
+
+	lruuid := ""
+	for uuid, drows := range odbi.cache[TableLogicalRouter] {
+		if rlr, ok := drows.Fields["name"].(string); ok && rlr == lr {
+			lruuid = uuid
+			break
+		}
+	}
+	if len(lruuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	lrRow := odbi.rowToLogicalRouter(lruuid)
+	for _, uuid := range lrRow.StaticRoutes {
+		lrsr := odbi.rowToLogicalRouterStaticRoute(uuid)
+		if lrsr == nil || lrsr.IPPrefix != spec.IPPrefix || lrsr.RouteTable != spec.RouteTable {
+			continue
+		}
+		policy := LRSRPolicyDstIP
+		if lrsr.Policy != nil {
+			policy = *lrsr.Policy
+		}
+		if spec.Policy != nil && policy != *spec.Policy {
+			continue
+		}
+		if len(spec.Nexthop) > 0 && lrsr.Nexthop != spec.Nexthop {
+			continue
+		}
+		if spec.OutputPort != nil && (lrsr.OutputPort == nil || *lrsr.OutputPort != *spec.OutputPort) {
+			continue
+		}
+		lrsrs = append(lrsrs, uuid)
+	}
+	return lrsrs, nil
+}
+
+// lrsrAddSpecImp mirrors lr-route-add: without ecmp a route to the same prefix
+// in the same route table is a duplicate, with ecmp only one via the same
+// nexthop is.
+func (odbi *ovndb) lrsrAddSpecImp(lr string, spec *LRSRSpec, ecmp bool) (*OvnCommand, error) {
+	if lr == "" {
+		return nil, fmt.Errorf("lr (logical router name) is required")
+	}
+	if spec == nil || spec.IPPrefix == "" || spec.Nexthop == "" {
+		return nil, fmt.Errorf("prefix and nexthop are required")
+	}
+	if spec.Policy != nil && *spec.Policy != LRSRPolicyDstIP && *spec.Policy != LRSRPolicySrcIP {
+		return nil, ErrorOption
+	}
+
+	match := *spec
+	if !ecmp {
+		match.Nexthop = ""
+	}
+	if match.Policy == nil {
+		policy := LRSRPolicyDstIP
+		match.Policy = &policy
+	}
+	odbi.cachemutex.RLock()
+	existing, err := odbi.lrsrMatchSpec(lr, &match)
+	odbi.cachemutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrorExist
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	row := make(OVNRow)
+	row["ip_prefix"] = spec.IPPrefix
+	row["nexthop"] = spec.Nexthop
+	if spec.OutputPort != nil {
+		row["output_port"] = *spec.OutputPort
+	}
+	if spec.Policy != nil {
+		row["policy"] = *spec.Policy
+	}
+	if len(spec.RouteTable) > 0 {
+		row["route_table"] = spec.RouteTable
+	}
+	if spec.BFD != nil {
+		row["bfd"] = stringToGoUUID(*spec.BFD)
+	}
+	if len(spec.SelectionFields) > 0 {
+		selectionFields, err := libovsdb.NewOvsSet(spec.SelectionFields)
+		if err != nil {
+			return nil, err
+		}
+		row["selection_fields"] = selectionFields
+	}
+	if spec.Options != nil {
+		oMap, err := libovsdb.NewOvsMap(spec.Options)
+		if err != nil {
+			return nil, err
+		}
+		row["options"] = oMap
+	}
+	if spec.ExternalID != nil {
+		oMap, err := libovsdb.NewOvsMap(spec.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		row["external_ids"] = oMap
+	}
+
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableLogicalRouterStaticRoute,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+
+	mutateSet, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(namedUUID)})
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("static_routes", opInsert, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", lr)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalRouter,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{insertOp, mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// lrsrDelSpecImp deletes every static route of lr matching spec. Without a
+// nexthop the whole ECMP group of the prefix is removed, with one only that
+// member. Like lr-route-del, a nil policy matches both policies.
+func (odbi *ovndb) lrsrDelSpecImp(lr string, spec *LRSRSpec) (*OvnCommand, error) {
+	if lr == "" {
+		return nil, fmt.Errorf("lr (logical router name) is required")
+	}
+	if spec == nil || spec.IPPrefix == "" {
+		return nil, fmt.Errorf("prefix is required")
+	}
+
+	odbi.cachemutex.RLock()
+	lrsrs, err := odbi.lrsrMatchSpec(lr, spec)
+	odbi.cachemutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(lrsrs) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	mutateUUID := make([]libovsdb.UUID, 0, len(lrsrs))
+	for _, uuid := range lrsrs {
+		mutateUUID = append(mutateUUID, stringToGoUUID(uuid))
+	}
+	mutateSet, err := libovsdb.NewOvsSet(mutateUUID)
+	if err != nil {
+		return nil, err
+	}
+	mutation := libovsdb.NewMutation("static_routes", opDelete, mutateSet)
+	condition := libovsdb.NewCondition("name", "==", lr)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableLogicalRouter,
+		Mutations: []interface{}{mutation},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// lrsrSetBFDImp attaches the BFD session with UUID bfd to the static route,
+// or detaches the current one when bfd is nil
+func (odbi *ovndb) lrsrSetBFDImp(uuid string, bfd *string) (*OvnCommand, error) {
+	odbi.cachemutex.RLock()
+	_, ok := odbi.cache[TableLogicalRouterStaticRoute][uuid]
+	if ok && bfd != nil {
+		_, ok = odbi.cache[TableBFD][*bfd]
+	}
+	odbi.cachemutex.RUnlock()
+	if !ok {
+		return nil, ErrorNotFound
+	}
+
+	row := make(OVNRow)
+	if bfd != nil {
+		row["bfd"] = stringToGoUUID(*bfd)
+	} else {
+		emptySet, err := libovsdb.NewOvsSet([]libovsdb.UUID{})
+		if err != nil {
+			return nil, err
+		}
+		row["bfd"] = emptySet
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableLogicalRouterStaticRoute,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
 func (odbi *ovndb) rowToLogicalRouterStaticRoute(uuid string) *LogicalRouterStaticRoute {
 	cacheLogicalRouterStaticRoute, ok := odbi.cache[TableLogicalRouterStaticRoute][uuid]
 	if !ok {
@@ -181,6 +421,29 @@ func (odbi *ovndb) rowToLogicalRouterStaticRoute(uuid string) *LogicalRouterStat
 	if outputPort, ok := cacheLogicalRouterStaticRoute.Fields["output_port"]; ok {
 		lrsr.OutputPort = odbi.optionalStringFieldToPointer(outputPort)
 	}
+	if routeTable, ok := cacheLogicalRouterStaticRoute.Fields["route_table"].(string); ok {
+		lrsr.RouteTable = routeTable
+	}
+	if selectionFields, ok := cacheLogicalRouterStaticRoute.Fields["selection_fields"]; ok {
+		switch selectionFields.(type) {
+		case string:
+			lrsr.SelectionFields = []string{selectionFields.(string)}
+		case libovsdb.OvsSet:
+			lrsr.SelectionFields = odbi.ConvertGoSetToStringArray(selectionFields.(libovsdb.OvsSet))
+		}
+	}
+	if options, ok := cacheLogicalRouterStaticRoute.Fields["options"].(libovsdb.OvsMap); ok {
+		lrsr.Options = options.GoMap
+	}
+	if bfd, ok := cacheLogicalRouterStaticRoute.Fields["bfd"]; ok {
+		switch bfd.(type) {
+		case libovsdb.UUID:
+			bfdUUID := bfd.(libovsdb.UUID).GoUUID
+			lrsr.BFD = &bfdUUID
+		case libovsdb.OvsSet:
+			lrsr.BFD = odbi.optionalStringFieldToPointer(bfd)
+		}
+	}
 	return lrsr
 }
 
@@ -189,3 +189,172 @@ func TestLogicalRouterStaticRoute(t *testing.T) {
 		assert.EqualError(t, ErrorNotFound, err.Error())
 	}
 }
+
+func TestLogicalRouterStaticRouteECMP(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	lrp := "lr2-tenant"
+	cmd, err := ovndbapi.LRAdd(LR2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRPAdd(LR2, lrp, "00:00:00:00:02:01", []string{"10.20.0.1/24"}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("Binding %s to route table tenant1", lrp)
+	cmd, err = ovndbapi.LRPSetRouteTable(lrp, "tenant1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrps, err := ovndbapi.LRPList(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "tenant1", lrps[0].Options["route_table"])
+
+	t.Logf("Adding ECMP routes to route table tenant1")
+	spec := &LRSRSpec{
+		IPPrefix:        "0.0.0.0/0",
+		Nexthop:         NEXTHOP,
+		RouteTable:      "tenant1",
+		SelectionFields: []string{"ip_src", "ip_dst"},
+		Options:         map[string]string{"ecmp_symmetric_reply": "true"},
+	}
+	cmd, err = ovndbapi.LRSRAddSpec(LR2, spec, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// same prefix without ecmp is a duplicate
+	second := *spec
+	second.Nexthop = nextHop2
+	_, err = ovndbapi.LRSRAddSpec(LR2, &second, false)
+	assert.Equal(t, ErrorExist, err)
+	// same nexthop with ecmp is a duplicate as well
+	_, err = ovndbapi.LRSRAddSpec(LR2, spec, true)
+	assert.Equal(t, ErrorExist, err)
+	cmd, err = ovndbapi.LRSRAddSpec(LR2, &second, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the main route table does not conflict with tenant1
+	cmd, err = ovndbapi.LRSRAddSpec(LR2, &LRSRSpec{IPPrefix: "0.0.0.0/0", Nexthop: NEXTHOP}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lrsr, err := ovndbapi.LRSRList(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, len(lrsr))
+	for _, sr := range lrsr {
+		if sr.RouteTable == "tenant1" {
+			assert.Equal(t, "true", sr.Options["ecmp_symmetric_reply"])
+			assert.ElementsMatch(t, []string{"ip_src", "ip_dst"}, sr.SelectionFields)
+		}
+	}
+
+	t.Logf("Deleting a single ECMP member")
+	cmd, err = ovndbapi.LRSRDelSpec(LR2, &LRSRSpec{IPPrefix: "0.0.0.0/0", Nexthop: nextHop2, RouteTable: "tenant1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrsr, err = ovndbapi.LRSRList(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(lrsr))
+
+	t.Logf("Deleting the remaining routes of route table tenant1")
+	cmd, err = ovndbapi.LRSRDelSpec(LR2, &LRSRSpec{IPPrefix: "0.0.0.0/0", RouteTable: "tenant1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrsr, err = ovndbapi.LRSRList(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(lrsr))
+	assert.Equal(t, "", lrsr[0].RouteTable)
+
+	t.Logf("Deleting routes of both policies")
+	srcIP := LRSRPolicySrcIP
+	cmd, err = ovndbapi.LRSRAddSpec(LR2, &LRSRSpec{IPPrefix: "0.0.0.0/0", Nexthop: NEXTHOP, Policy: &srcIP}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ovndbapi.LRSRDelSpec(LR2, &LRSRSpec{IPPrefix: "0.0.0.0/0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrsr, err = ovndbapi.LRSRList(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(lrsr))
+
+	cmd, err = ovndbapi.LRPSetRouteTable(lrp, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lrps, err = ovndbapi.LRPList(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok := lrps[0].Options["route_table"]
+	assert.False(t, ok)
+
+	cmd, err = ovndbapi.LRDel(LR2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -166,3 +166,86 @@ func TestLogicalRouter(t *testing.T) {
 	}
 
 }
+
+func TestLogicalRouterOptions(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmd, err := ovndbapi.LRAdd(LR3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.LRSetOptions(FAKENOROUTER, map[string]string{LROptionChassis: CHASSIS_NAME})
+	assert.Equal(t, ErrorNotFound, err)
+	_, err = ovndbapi.LRSetOptions(LR3, map[string]string{LROptionSNATCTZone: "zone"})
+	assert.Equal(t, ErrorOption, err)
+	_, err = ovndbapi.LRSetOptions(LR3, map[string]string{LROptionLBForceSNATIP: "not-an-ip"})
+	assert.Equal(t, ErrorOption, err)
+
+	cmd, err = ovndbapi.LRSetOptions(LR3, map[string]string{
+		LROptionChassis:             CHASSIS_NAME,
+		LROptionDynamicNeighRouters: "true",
+		LROptionLBForceSNATIP:       "172.16.0.1 fd00::1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// options not given are kept
+	cmd, err = ovndbapi.LRSetOptions(LR3, map[string]string{LROptionSNATCTZone: "100"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.LRSetEnabled(LR3, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err := ovndbapi.LRGetOptions(LR3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{
+		LROptionChassis:             CHASSIS_NAME,
+		LROptionDynamicNeighRouters: "true",
+		LROptionLBForceSNATIP:       "172.16.0.1 fd00::1",
+		LROptionSNATCTZone:          "100",
+	}, options)
+	lrs, err := ovndbapi.LRGet(LR3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, false, lrs[0].Enabled)
+
+	cmd, err = ovndbapi.LRDelOptions(LR3, []string{LROptionChassis, LROptionLBForceSNATIP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err = ovndbapi.LRGetOptions(LR3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{LROptionDynamicNeighRouters: "true", LROptionSNATCTZone: "100"}, options)
+
+	cmd, err = ovndbapi.LRDel(LR3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -18,6 +18,8 @@ package goovn
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/ebay/libovsdb"
 )
@@ -28,6 +30,8 @@ type LogicalSwitch struct {
 	Name         string
 	Ports        []string
 	LoadBalancer []string
+	LBGroups     []string
+	Copp         *string
 	ACLs         []string
 	QoSRules     []string
 	DNSRecords   []string
@@ -98,6 +102,17 @@ func (odbi *ovndb) rowToLogicalSwitch(uuid string) *LogicalSwitch {
 			ls.LoadBalancer = odbi.ConvertGoSetToStringArray(lbs.(libovsdb.OvsSet))
 		}
 	}
+	if lbGroups, ok := cacheLogicalSwitch.Fields["load_balancer_group"]; ok {
+		switch lbGroups.(type) {
+		case libovsdb.UUID:
+			ls.LBGroups = []string{lbGroups.(libovsdb.UUID).GoUUID}
+		case libovsdb.OvsSet:
+			ls.LBGroups = odbi.ConvertGoSetToStringArray(lbGroups.(libovsdb.OvsSet))
+		}
+	}
+	if copp, ok := cacheLogicalSwitch.Fields["copp"]; ok {
+		ls.Copp = odbi.optionalStringFieldToPointer(copp)
+	}
 	if acls, ok := cacheLogicalSwitch.Fields["acls"]; ok {
 		switch acls.(type) {
 		case libovsdb.UUID:
@@ -331,6 +346,90 @@ func (odbi *ovndb) lsExtIdsDelImp(ls string, external_ids map[string]string) (*O
 	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
 }
 
+// LSMulticastConfig holds the IGMP/MLD snooping knobs of a logical switch,
+// stored in other_config. Nil or empty fields are left untouched.
+type LSMulticastConfig struct {
+	Snoop             *bool
+	Querier           *bool
+	FloodUnregistered *bool
+	TableSize         *int
+	IdleTimeout       *int
+	QueryInterval     *int
+	QueryMaxResponse  *int
+	EthSrc            string
+	IP4Src            string
+	IP6Src            string
+}
+
+// otherConfig validates cfg and converts it to other_config keys
+func (cfg *LSMulticastConfig) otherConfig() (map[string]string, error) {
+	kv := make(map[string]string)
+	for key, value := range map[string]*bool{
+		"mcast_snoop":              cfg.Snoop,
+		"mcast_querier":            cfg.Querier,
+		"mcast_flood_unregistered": cfg.FloodUnregistered,
+	} {
+		if value != nil {
+			kv[key] = strconv.FormatBool(*value)
+		}
+	}
+	for key, value := range map[string]struct {
+		v        *int
+		min, max int
+	}{
+		"mcast_table_size":         {cfg.TableSize, 1, 32766},
+		"mcast_idle_timeout":       {cfg.IdleTimeout, 15, 3600},
+		"mcast_query_interval":     {cfg.QueryInterval, 1, 3600},
+		"mcast_query_max_response": {cfg.QueryMaxResponse, 1, 10},
+	} {
+		if value.v == nil {
+			continue
+		}
+		if *value.v < value.min || *value.v > value.max {
+			return nil, fmt.Errorf("%s must be in range %d..%d", key, value.min, value.max)
+		}
+		kv[key] = strconv.Itoa(*value.v)
+	}
+	if len(cfg.EthSrc) > 0 {
+		if _, err := net.ParseMAC(cfg.EthSrc); err != nil {
+			return nil, fmt.Errorf("invalid mcast_eth_src %s: %s", cfg.EthSrc, err)
+		}
+		kv["mcast_eth_src"] = cfg.EthSrc
+	}
+	if len(cfg.IP4Src) > 0 {
+		if ip := net.ParseIP(cfg.IP4Src); ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("invalid mcast_ip4_src %s", cfg.IP4Src)
+		}
+		kv["mcast_ip4_src"] = cfg.IP4Src
+	}
+	if len(cfg.IP6Src) > 0 {
+		if ip := net.ParseIP(cfg.IP6Src); ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("invalid mcast_ip6_src %s", cfg.IP6Src)
+		}
+		kv["mcast_ip6_src"] = cfg.IP6Src
+	}
+	return kv, nil
+}
+
+func (odbi *ovndb) lsSetMulticastConfigImp(ls string, cfg *LSMulticastConfig) (*OvnCommand, error) {
+	if cfg == nil {
+		return nil, ErrorOption
+	}
+	kv, err := cfg.otherConfig()
+	if err != nil {
+		return nil, err
+	}
+	if len(kv) == 0 {
+		return nil, ErrorNoChanges
+	}
+	row := make(OVNRow)
+	row["name"] = ls
+	if uuid := odbi.getRowUUID(TableLogicalSwitch, row); len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.auxKeyValSet(TableLogicalSwitch, ls, "other_config", kv)
+}
+
 func (odbi *ovndb) linkSwitchToRouterImp(lsw, lsp, lr, lrp, lrpMac string, networks []string, externalIds map[string]string) (*OvnCommand, error) {
 	// validate logical switch
 	row := make(OVNRow)
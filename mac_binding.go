@@ -0,0 +1,168 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+
+	"github.com/ebay/libovsdb"
+)
+
+// MACBinding table OVN SB
+type MACBinding struct {
+	UUID        string
+	LogicalPort string
+	IP          string
+	MAC         string
+	Datapath    string
+	// Timestamp is the time in ms the binding was last updated, 0 when the
+	// schema has no timestamp column
+	Timestamp int64
+}
+
+// MACBindingFilter selects mac bindings, zero values match everything.
+// MACBindingDel requires at least one field to be set.
+type MACBindingFilter struct {
+	LogicalPort string
+	IP          string
+	// Datapath is the uuid or the name of a Datapath_Binding
+	Datapath string
+}
+
+// macBindingSelect returns the mac bindings for which match returns true,
+// caller must hold cachemutex
+func (odbi *ovndb) macBindingSelect(match func(*MACBinding) bool) ([]*MACBinding, error) {
+	cacheBinding, ok := odbi.cache[TableMACBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	var listBinding []*MACBinding
+	for uuid := range cacheBinding {
+		binding := odbi.rowToMACBinding(uuid)
+		if match(binding) {
+			listBinding = append(listBinding, binding)
+		}
+	}
+	return listBinding, nil
+}
+
+// macBindingFilterFunc turns filter into a predicate, caller must hold cachemutex
+func (odbi *ovndb) macBindingFilterFunc(filter *MACBindingFilter) (func(*MACBinding) bool, error) {
+	if filter == nil {
+		return func(*MACBinding) bool { return true }, nil
+	}
+	var datapaths map[string]bool
+	if len(filter.Datapath) > 0 {
+		var err error
+		datapaths, err = odbi.datapathBindingResolve(filter.Datapath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return func(binding *MACBinding) bool {
+		if len(filter.LogicalPort) > 0 && binding.LogicalPort != filter.LogicalPort {
+			return false
+		}
+		if len(filter.IP) > 0 && binding.IP != filter.IP {
+			return false
+		}
+		if datapaths != nil && !datapaths[binding.Datapath] {
+			return false
+		}
+		return true
+	}, nil
+}
+
+func (odbi *ovndb) macBindingListImp(filter *MACBindingFilter) ([]*MACBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	match, err := odbi.macBindingFilterFunc(filter)
+	if err != nil {
+		return nil, err
+	}
+	return odbi.macBindingSelect(match)
+}
+
+// macBindingDelImp deletes all mac bindings passing filter in one command,
+// use macBindingDelFuncImp to delete them all
+func (odbi *ovndb) macBindingDelImp(filter *MACBindingFilter) (*OvnCommand, error) {
+	if filter == nil || (len(filter.LogicalPort) == 0 && len(filter.IP) == 0 && len(filter.Datapath) == 0) {
+		return nil, fmt.Errorf("mac binding filter cannot be empty")
+	}
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	match, err := odbi.macBindingFilterFunc(filter)
+	if err != nil {
+		return nil, err
+	}
+	return odbi.macBindingDelMatching(match)
+}
+
+// macBindingDelFuncImp deletes all mac bindings for which match returns true
+// in one command. match is called with the cache locked and must not call
+// back into the client.
+func (odbi *ovndb) macBindingDelFuncImp(match func(*MACBinding) bool) (*OvnCommand, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	return odbi.macBindingDelMatching(match)
+}
+
+// macBindingDelMatching builds the delete command, caller must hold cachemutex
+func (odbi *ovndb) macBindingDelMatching(match func(*MACBinding) bool) (*OvnCommand, error) {
+	bindings, err := odbi.macBindingSelect(match)
+	if err != nil {
+		return nil, err
+	}
+	if len(bindings) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	operations := make([]libovsdb.Operation, 0, len(bindings))
+	for _, binding := range bindings {
+		condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(binding.UUID))
+		deleteOp := libovsdb.Operation{
+			Op:    opDelete,
+			Table: TableMACBinding,
+			Where: []interface{}{condition},
+		}
+		operations = append(operations, deleteOp)
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rowToMACBinding(uuid string) *MACBinding {
+	cacheBinding, ok := odbi.cache[TableMACBinding][uuid]
+	if !ok {
+		return nil
+	}
+
+	binding := &MACBinding{
+		UUID:        uuid,
+		LogicalPort: cacheBinding.Fields["logical_port"].(string),
+		IP:          cacheBinding.Fields["ip"].(string),
+		MAC:         cacheBinding.Fields["mac"].(string),
+	}
+	if datapath, ok := cacheBinding.Fields["datapath"].(libovsdb.UUID); ok {
+		binding.Datapath = datapath.GoUUID
+	}
+	binding.Timestamp = int64FieldValue(cacheBinding.Fields["timestamp"])
+	return binding
+}
@@ -0,0 +1,124 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	MB_DP_KEY = 4301
+	MB_DP     = "mb-lr"
+	MB_LRP1   = "mb-lrp1"
+	MB_LRP2   = "mb-lrp2"
+)
+
+func sbTestMACBindingAdd(t *testing.T, ovndbapi Client, datapath string, logicalPort string, ip string, mac string) *OvnCommand {
+	row := make(OVNRow)
+	row["datapath"] = stringToGoUUID(datapath)
+	row["logical_port"] = logicalPort
+	row["ip"] = ip
+	row["mac"] = mac
+	insertOp := libovsdb.Operation{
+		Op:    opInsert,
+		Table: TableMACBinding,
+		Row:   row,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+}
+
+func TestMACBinding(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	dpCmd, dp := sbTestDatapathAdd(t, ovndbapi, MB_DP_KEY, map[string]string{"name": MB_DP})
+	uuids, err := ovndbapi.ExecuteR(dpCmd,
+		sbTestMACBindingAdd(t, ovndbapi, dp, MB_LRP1, "172.20.0.10", "00:00:00:00:06:10"),
+		sbTestMACBindingAdd(t, ovndbapi, dp, MB_LRP1, "172.20.0.11", "00:00:00:00:06:11"),
+		sbTestMACBindingAdd(t, ovndbapi, dp, MB_LRP2, "172.21.0.10", "00:00:00:00:06:20"),
+		sbTestMACBindingAdd(t, ovndbapi, dp, MB_LRP2, "172.21.0.11", "00:00:00:00:06:21"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpUUID := uuids[0]
+
+	bindings, err := ovndbapi.MACBindingList(&MACBindingFilter{Datapath: MB_DP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 4, len(bindings))
+	bindings, err = ovndbapi.MACBindingList(&MACBindingFilter{LogicalPort: MB_LRP1, IP: "172.20.0.11"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(bindings)) {
+		assert.Equal(t, "00:00:00:00:06:11", bindings[0].MAC)
+		assert.Equal(t, dpUUID, bindings[0].Datapath)
+	}
+	_, err = ovndbapi.MACBindingList(&MACBindingFilter{Datapath: FAKENOROUTER})
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err := ovndbapi.MACBindingDel(&MACBindingFilter{Datapath: dpUUID, LogicalPort: MB_LRP1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindings, err = ovndbapi.MACBindingList(&MACBindingFilter{Datapath: dpUUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(bindings))
+
+	cmd, err = ovndbapi.MACBindingDelFunc(func(mb *MACBinding) bool {
+		return mb.Datapath == dpUUID && mb.MAC == "00:00:00:00:06:20"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindings, err = ovndbapi.MACBindingList(&MACBindingFilter{Datapath: dpUUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(bindings)) {
+		assert.Equal(t, "172.21.0.11", bindings[0].IP)
+	}
+
+	_, err = ovndbapi.MACBindingDel(nil)
+	assert.Error(t, err)
+	_, err = ovndbapi.MACBindingDel(&MACBindingFilter{})
+	assert.Error(t, err)
+	cmd, err = ovndbapi.MACBindingDel(&MACBindingFilter{Datapath: dpUUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, sbTestDatapathDel(t, ovndbapi, dpUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.MACBindingDel(&MACBindingFilter{LogicalPort: MB_LRP2})
+	assert.Equal(t, ErrorNotFound, err)
+}
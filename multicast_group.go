@@ -0,0 +1,86 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// MulticastGroup table OVN SB
+type MulticastGroup struct {
+	UUID      string
+	Name      string
+	Datapath  string
+	TunnelKey int
+	// Ports are uuids of Port_Binding rows
+	Ports []string
+}
+
+// multicastGroupListImp lists the multicast groups of datapath, given by uuid
+// or name, or all of them when datapath is empty
+func (odbi *ovndb) multicastGroupListImp(datapath string) ([]*MulticastGroup, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGroup, ok := odbi.cache[TableMulticastGroup]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	var datapaths map[string]bool
+	if len(datapath) > 0 {
+		var err error
+		datapaths, err = odbi.datapathBindingResolve(datapath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listGroup := make([]*MulticastGroup, 0, len(cacheGroup))
+	for uuid := range cacheGroup {
+		group := odbi.rowToMulticastGroup(uuid)
+		if datapaths != nil && !datapaths[group.Datapath] {
+			continue
+		}
+		listGroup = append(listGroup, group)
+	}
+	return listGroup, nil
+}
+
+func (odbi *ovndb) rowToMulticastGroup(uuid string) *MulticastGroup {
+	cacheGroup, ok := odbi.cache[TableMulticastGroup][uuid]
+	if !ok {
+		return nil
+	}
+
+	group := &MulticastGroup{
+		UUID: uuid,
+		Name: cacheGroup.Fields["name"].(string),
+	}
+	if datapath, ok := cacheGroup.Fields["datapath"].(libovsdb.UUID); ok {
+		group.Datapath = datapath.GoUUID
+	}
+	if tunnelKey, ok := cacheGroup.Fields["tunnel_key"].(int); ok {
+		group.TunnelKey = tunnelKey
+	}
+	switch ports := cacheGroup.Fields["ports"].(type) {
+	case libovsdb.UUID:
+		group.Ports = []string{ports.GoUUID}
+	case libovsdb.OvsSet:
+		group.Ports = odbi.ConvertGoSetToStringArray(ports)
+	}
+	return group
+}
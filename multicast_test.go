@@ -0,0 +1,162 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	MCAST_LS     = "mcast-ls"
+	MCAST_LR     = "mcast-lr"
+	MCAST_DP_KEY = 4501
+	MCAST_LSP    = "mcast-lsp"
+	MCAST_ADDR   = "239.0.0.1"
+)
+
+func TestMulticastConfig(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmd, err := ovndbapi.LSAdd(MCAST_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.LRAdd(MCAST_LR, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snoop := true
+	tableSize := 4096
+	idleTimeout := 5
+	_, err = ovndbapi.LSSetMulticastConfig(MCAST_LS, &LSMulticastConfig{IdleTimeout: &idleTimeout})
+	assert.Error(t, err)
+	_, err = ovndbapi.LSSetMulticastConfig(MCAST_LS, &LSMulticastConfig{IP4Src: "fd00::1"})
+	assert.Error(t, err)
+	_, err = ovndbapi.LSSetMulticastConfig(MCAST_LS, &LSMulticastConfig{})
+	assert.Equal(t, ErrorNoChanges, err)
+
+	cmd, err = ovndbapi.LSSetMulticastConfig(MCAST_LS, &LSMulticastConfig{
+		Snoop:     &snoop,
+		Querier:   &snoop,
+		TableSize: &tableSize,
+		EthSrc:    "00:00:00:00:08:01",
+		IP4Src:    "169.254.0.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err = ovndbapi.LRSetMulticastRelay(MCAST_LR, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lss, err := ovndbapi.LSGet(MCAST_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "true", lss[0].OtherConfig["mcast_snoop"])
+	assert.Equal(t, "true", lss[0].OtherConfig["mcast_querier"])
+	assert.Equal(t, "4096", lss[0].OtherConfig["mcast_table_size"])
+	assert.Equal(t, "169.254.0.1", lss[0].OtherConfig["mcast_ip4_src"])
+	options, err := ovndbapi.LRGetOptions(MCAST_LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "true", options[LROptionMcastRelay])
+
+	cmd, err = ovndbapi.LSDel(MCAST_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err = ovndbapi.LRDel(MCAST_LR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMulticastGroups(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	dpCmd, dp := sbTestDatapathAdd(t, ovndbapi, MCAST_DP_KEY, map[string]string{"name": MCAST_LS})
+	pbUUID, err := newRowUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbCmd := sbTestPortBindingAdd(t, ovndbapi, dp, MCAST_LSP, 1, "")
+	pbCmd.Operations[0].UUIDName = pbUUID
+	ports, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(pbUUID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mgRow := OVNRow{"name": "_MC_flood", "datapath": stringToGoUUID(dp), "tunnel_key": 32768, "ports": ports}
+	igmpRow := OVNRow{"address": MCAST_ADDR, "datapath": stringToGoUUID(dp), "ports": ports}
+	operations := []libovsdb.Operation{
+		{Op: opInsert, Table: TableMulticastGroup, Row: mgRow},
+		{Op: opInsert, Table: TableIGMPGroup, Row: igmpRow},
+	}
+	groupCmd := &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+	uuids, err := ovndbapi.ExecuteR(dpCmd, pbCmd, groupCmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpUUID := uuids[0]
+
+	mgs, err := ovndbapi.MulticastGroupList(MCAST_LS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(mgs)) {
+		assert.Equal(t, "_MC_flood", mgs[0].Name)
+		assert.Equal(t, 32768, mgs[0].TunnelKey)
+		assert.Equal(t, []string{uuids[1]}, mgs[0].Ports)
+	}
+	igmps, err := ovndbapi.IGMPGroupList(dpUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Equal(t, 1, len(igmps)) {
+		assert.Equal(t, MCAST_ADDR, igmps[0].Address)
+		assert.Nil(t, igmps[0].Chassis)
+		assert.Equal(t, []string{uuids[1]}, igmps[0].Ports)
+	}
+	_, err = ovndbapi.IGMPGroupList(FAKENOSWITCH)
+	assert.Equal(t, ErrorNotFound, err)
+
+	operations = []libovsdb.Operation{
+		{Op: opDelete, Table: TableIGMPGroup, Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(igmps[0].UUID))}},
+		{Op: opDelete, Table: TableMulticastGroup, Where: []interface{}{libovsdb.NewCondition("_uuid", "==", stringToGoUUID(mgs[0].UUID))}},
+	}
+	groupCmd = &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+	err = ovndbapi.Execute(groupCmd, sbTestDatapathDel(t, ovndbapi, dpUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -16,10 +16,37 @@
 
 package goovn
 
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/ebay/libovsdb"
+)
+
+// NB_Global options
+const (
+	NBGlobalOptionMACPrefix           string = "mac_prefix"
+	NBGlobalOptionSvcMonitorMAC       string = "svc_monitor_mac"
+	NBGlobalOptionNorthdProbeInterval string = "northd_probe_interval"
+	NBGlobalOptionUseLogicalDPGroups  string = "use_logical_dp_groups"
+)
+
 type NBGlobalTableRow struct {
-	UUID        string
-	Options     map[interface{}]interface{}
-	ExternalID  map[interface{}]interface{}
+	UUID string
+	Name string
+	// NbCfg is bumped by the CMS, SbCfg and HvCfg report the sequence number
+	// ovn-northd and all the chassis caught up with, timestamps are in
+	// milliseconds since the epoch
+	NbCfg          int
+	NbCfgTimestamp int64
+	SbCfg          int
+	SbCfgTimestamp int64
+	HvCfg          int
+	HvCfgTimestamp int64
+	Options        map[interface{}]interface{}
+	ExternalID     map[interface{}]interface{}
+	// Connections and SSL are uuids of Connection and SSL rows
 	Connections []string
 	SSL         string
 	IPSec       bool
@@ -43,3 +70,81 @@ func (odbi *ovndb) nbGlobalSetOptionsImp(options map[string]string) (*OvnCommand
 func (odbi *ovndb) nbGlobalGetOptionsImp() (map[string]string, error) {
 	return odbi.globalGetOptionsImp(TableNBGlobal)
 }
+
+func (odbi *ovndb) nbGlobalGetImp() (*NBGlobalTableRow, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGlobal, ok := odbi.cache[TableNBGlobal]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	for uuid, drows := range cacheGlobal {
+		row := &NBGlobalTableRow{UUID: uuid}
+		row.Options, row.ExternalID, row.Connections, row.SSL, row.IPSec = odbi.globalRowCommon(drows.Fields)
+		row.Name, _ = drows.Fields["name"].(string)
+		row.NbCfg, _ = drows.Fields["nb_cfg"].(int)
+		row.NbCfgTimestamp = int64FieldValue(drows.Fields["nb_cfg_timestamp"])
+		row.SbCfg, _ = drows.Fields["sb_cfg"].(int)
+		row.SbCfgTimestamp = int64FieldValue(drows.Fields["sb_cfg_timestamp"])
+		row.HvCfg, _ = drows.Fields["hv_cfg"].(int)
+		row.HvCfgTimestamp = int64FieldValue(drows.Fields["hv_cfg_timestamp"])
+		return row, nil
+	}
+	return nil, ErrorNotFound
+}
+
+// nbGlobalIncNbCfgImp bumps nb_cfg in place, so concurrent writers do not
+// lose increments
+func (odbi *ovndb) nbGlobalIncNbCfgImp() (*OvnCommand, error) {
+	uuid, err := odbi.globalRowUUID(TableNBGlobal)
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableNBGlobal,
+		Mutations: []interface{}{libovsdb.NewMutation("nb_cfg", "+=", 1)},
+		Where:     []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{mutateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) nbGlobalSetNameImp(name string) (*OvnCommand, error) {
+	return odbi.globalUpdateImp(TableNBGlobal, OVNRow{"name": name})
+}
+
+func (odbi *ovndb) nbGlobalSetExternalIDsImp(external_ids map[string]string) (*OvnCommand, error) {
+	return odbi.globalMergeMapImp(TableNBGlobal, "external_ids", external_ids)
+}
+
+// nbGlobalSetMACPrefixImp sets the first three bytes of the dynamically
+// allocated MAC addresses, e.g. "0a:00:00"
+func (odbi *ovndb) nbGlobalSetMACPrefixImp(prefix string) (*OvnCommand, error) {
+	if _, err := net.ParseMAC(prefix + ":00:00:00"); err != nil || len(prefix) != 8 {
+		return nil, fmt.Errorf("invalid mac prefix %s", prefix)
+	}
+	return odbi.globalMergeMapImp(TableNBGlobal, "options", map[string]string{NBGlobalOptionMACPrefix: prefix})
+}
+
+func (odbi *ovndb) nbGlobalSetSvcMonitorMACImp(mac string) (*OvnCommand, error) {
+	if _, err := net.ParseMAC(mac); err != nil {
+		return nil, err
+	}
+	return odbi.globalMergeMapImp(TableNBGlobal, "options", map[string]string{NBGlobalOptionSvcMonitorMAC: mac})
+}
+
+// nbGlobalSetNorthdProbeIntervalImp sets the inactivity probe interval of
+// ovn-northd in milliseconds, 0 disables it
+func (odbi *ovndb) nbGlobalSetNorthdProbeIntervalImp(interval int) (*OvnCommand, error) {
+	if interval < 0 {
+		return nil, fmt.Errorf("invalid probe interval %d", interval)
+	}
+	return odbi.globalMergeMapImp(TableNBGlobal, "options", map[string]string{NBGlobalOptionNorthdProbeInterval: strconv.Itoa(interval)})
+}
+
+func (odbi *ovndb) nbGlobalSetUseLogicalDPGroupsImp(enable bool) (*OvnCommand, error) {
+	return odbi.globalMergeMapImp(TableNBGlobal, "options", map[string]string{NBGlobalOptionUseLogicalDPGroups: strconv.FormatBool(enable)})
+}
@@ -80,3 +80,98 @@ func TestNBGlobalAPI(t *testing.T) {
 	err = ovndbapi.Execute(cmd)
 	assert.Equal(t, err == nil, true)
 }
+
+func TestNBGlobalRow(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	ovn := ovndbapi.(*ovndb)
+	cmd, err := ovn.nbGlobalAdd(map[string]string{NB_GLOBAL_DUMMY_OPT_KEY: NB_GLOBAL_DUMMY_OPT_VAL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ovndbapi.NBGlobalSetMACPrefix("0a:00")
+	assert.Error(t, err)
+	_, err = ovndbapi.NBGlobalSetSvcMonitorMAC("not-a-mac")
+	assert.Error(t, err)
+	_, err = ovndbapi.NBGlobalSetNorthdProbeInterval(-1)
+	assert.Error(t, err)
+
+	// setters mutate single keys, so they can share a transaction
+	var cmds []*OvnCommand
+	cmd, err = ovndbapi.NBGlobalSetName("az1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.NBGlobalSetExternalIDs(map[string]string{"owner": "goovn"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.NBGlobalSetMACPrefix("0a:00:01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.NBGlobalSetSvcMonitorMAC("0a:00:00:00:00:fe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.NBGlobalSetNorthdProbeInterval(10000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	cmd, err = ovndbapi.NBGlobalSetUseLogicalDPGroups(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds = append(cmds, cmd)
+	err = ovndbapi.Execute(cmds...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := ovndbapi.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "az1", row.Name)
+	assert.Equal(t, "goovn", row.ExternalID["owner"])
+	assert.Equal(t, NB_GLOBAL_DUMMY_OPT_VAL, row.Options[NB_GLOBAL_DUMMY_OPT_KEY])
+	assert.Equal(t, "0a:00:01", row.Options[NBGlobalOptionMACPrefix])
+	assert.Equal(t, "0a:00:00:00:00:fe", row.Options[NBGlobalOptionSvcMonitorMAC])
+	assert.Equal(t, "10000", row.Options[NBGlobalOptionNorthdProbeInterval])
+	assert.Equal(t, "true", row.Options[NBGlobalOptionUseLogicalDPGroups])
+	assert.False(t, row.IPSec)
+	assert.Empty(t, row.Connections)
+	assert.Equal(t, "", row.SSL)
+
+	cmd, err = ovndbapi.NBGlobalIncNbCfg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	incRow, err := ovndbapi.NBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, row.NbCfg+1, incRow.NbCfg)
+
+	cmd, err = ovn.nbGlobalDel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
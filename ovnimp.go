@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/ebay/libovsdb"
 )
@@ -231,25 +232,31 @@ func (odbi *ovndb) populateCache(updates libovsdb.TableUpdates) {
 	defer odbi.cachemutex.Unlock()
 
 	for table := range odbi.tableCols {
+		// empty tables are left out of monitor replies, create their cache
+		// anyway so that they list as empty rather than missing
+		if _, ok := odbi.cache[table]; !ok {
+			odbi.cache[table] = make(map[string]libovsdb.Row)
+		}
 		tableUpdate, ok := updates.Updates[table]
 		if !ok {
 			continue
 		}
 
-		if _, ok := odbi.cache[table]; !ok {
-			odbi.cache[table] = make(map[string]libovsdb.Row)
-		}
 		for uuid, row := range tableUpdate.Rows {
 			// TODO: this is a workaround for the problem of
 			// missing json number conversion in libovsdb
 			odbi.float64_to_int(row.New)
 
 			if !reflect.DeepEqual(row.New, empty) {
-				if reflect.DeepEqual(row.New, odbi.cache[table][uuid]) {
+				oldRow := odbi.cache[table][uuid]
+				if reflect.DeepEqual(row.New, oldRow) {
 					// Already existed and unchanged, ignore (this can happen when auto-reconnect)
 					continue
 				}
 				odbi.cache[table][uuid] = row.New
+				if table == TableSBGlobal && odbi.nbCfgHistory != nil {
+					odbi.recordNbCfg(int(int64FieldValue(row.New.Fields["nb_cfg"])), time.Now())
+				}
 
 				if odbi.signalCB != nil {
 					switch table {
@@ -279,21 +286,47 @@ func (odbi *ovndb) populateCache(updates libovsdb.TableUpdates) {
 					case TableQoS:
 						qos := odbi.rowToQoS(uuid)
 						odbi.signalCB.OnQoSCreate(qos)
+					// the south bound tables of the same names have their own models
 					case TableLoadBalancer:
-						lb, _ := odbi.rowToLB(uuid)
-						odbi.signalCB.OnLoadBalancerCreate(lb)
+						if odbi.db == DBNB {
+							lb, _ := odbi.rowToLB(uuid)
+							odbi.signalCB.OnLoadBalancerCreate(lb)
+						}
 					case TableMeter:
-						meter := odbi.rowToMeter(uuid)
-						odbi.signalCB.OnMeterCreate(meter)
+						if odbi.db == DBNB {
+							meter := odbi.rowToMeter(uuid)
+							odbi.signalCB.OnMeterCreate(meter)
+						}
 					case TableMeterBand:
-						band, _ := odbi.rowToMeterBand(uuid)
-						odbi.signalCB.OnMeterBandCreate(band)
+						if odbi.db == DBNB {
+							band, _ := odbi.rowToMeterBand(uuid)
+							odbi.signalCB.OnMeterBandCreate(band)
+						}
+					case TableForwardingGroup:
+						group := odbi.rowToForwardingGroup(uuid)
+						odbi.signalCB.OnForwardingGroupCreate(group)
 					case TableChassis:
 						chassis, _ := odbi.rowToChassis(uuid)
 						odbi.signalCB.OnChassisCreate(chassis)
 					case TableEncap:
 						encap, _ := odbi.rowToEncap(uuid)
 						odbi.signalCB.OnEncapCreate(encap)
+					case TablePortBinding:
+						odbi.signalPortBindingUpdate(uuid, oldRow)
+					case TableServiceMonitor:
+						odbi.signalServiceMonitorUpdate(uuid, oldRow)
+					case TableIGMPGroup:
+						group := odbi.rowToIGMPGroup(uuid)
+						odbi.signalCB.OnIGMPGroupCreate(group)
+					case TableMulticastGroup:
+						group := odbi.rowToMulticastGroup(uuid)
+						odbi.signalCB.OnMulticastGroupCreate(group)
+					case TableControllerEvent:
+						// deliver each event once, when its row is inserted
+						if len(oldRow.Fields) == 0 {
+							ev := odbi.rowToControllerEvent(uuid)
+							odbi.signalCB.OnControllerEvent(ev)
+						}
 					}
 				}
 			} else {
@@ -329,20 +362,39 @@ func (odbi *ovndb) populateCache(updates libovsdb.TableUpdates) {
 							qos := odbi.rowToQoS(uuid)
 							odbi.signalCB.OnQoSDelete(qos)
 						case TableLoadBalancer:
-							lb, _ := odbi.rowToLB(uuid)
-							odbi.signalCB.OnLoadBalancerDelete(lb)
+							if odbi.db == DBNB {
+								lb, _ := odbi.rowToLB(uuid)
+								odbi.signalCB.OnLoadBalancerDelete(lb)
+							}
 						case TableMeter:
-							meter := odbi.rowToMeter(uuid)
-							odbi.signalCB.OnMeterDelete(meter)
+							if odbi.db == DBNB {
+								meter := odbi.rowToMeter(uuid)
+								odbi.signalCB.OnMeterDelete(meter)
+							}
 						case TableMeterBand:
-							band, _ := odbi.rowToMeterBand(uuid)
-							odbi.signalCB.OnMeterBandDelete(band)
+							if odbi.db == DBNB {
+								band, _ := odbi.rowToMeterBand(uuid)
+								odbi.signalCB.OnMeterBandDelete(band)
+							}
+						case TableForwardingGroup:
+							group := odbi.rowToForwardingGroup(uuid)
+							odbi.signalCB.OnForwardingGroupDelete(group)
 						case TableChassis:
 							chassis, _ := odbi.rowToChassis(uuid)
 							odbi.signalCB.OnChassisDelete(chassis)
 						case TableEncap:
 							encap, _ := odbi.rowToEncap(uuid)
 							odbi.signalCB.OnEncapDelete(encap)
+						case TablePortBinding:
+							if pb := odbi.rowToPortBinding(uuid); pb != nil && pb.Chassis != nil {
+								odbi.signalCB.OnPortBindingUnbind(pb)
+							}
+						case TableIGMPGroup:
+							group := odbi.rowToIGMPGroup(uuid)
+							odbi.signalCB.OnIGMPGroupDelete(group)
+						case TableMulticastGroup:
+							group := odbi.rowToMulticastGroup(uuid)
+							odbi.signalCB.OnMulticastGroupDelete(group)
 						}
 					}(table, uuid)
 				}
@@ -371,6 +423,9 @@ func (odbi *ovndb) optionalStringFieldToPointer(fieldValue interface{}) *string
 	case string:
 		temp := fieldValue.(string)
 		return &temp
+	case libovsdb.UUID:
+		temp := fieldValue.(libovsdb.UUID).GoUUID
+		return &temp
 	case libovsdb.OvsSet:
 		temp := odbi.ConvertGoSetToStringArray(fieldValue.(libovsdb.OvsSet))
 		if len(temp) > 0 {
@@ -381,6 +436,35 @@ func (odbi *ovndb) optionalStringFieldToPointer(fieldValue interface{}) *string
 	return nil
 }
 
+func (odbi *ovndb) optionalIntFieldToPointer(fieldValue interface{}) *int {
+	switch fieldValue.(type) {
+	case int:
+		temp := fieldValue.(int)
+		return &temp
+	case float64:
+		temp := int(fieldValue.(float64))
+		return &temp
+	case libovsdb.OvsSet:
+		for _, v := range fieldValue.(libovsdb.OvsSet).GoSet {
+			return odbi.optionalIntFieldToPointer(v)
+		}
+		return nil
+	}
+	return nil
+}
+
+// int64FieldValue returns an integer column as int64, values that do not fit
+// in int are left as float64 in the cache on 32-bit builds
+func int64FieldValue(fieldValue interface{}) int64 {
+	switch v := fieldValue.(type) {
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	}
+	return 0
+}
+
 func stringToGoUUID(uuid string) libovsdb.UUID {
 	return libovsdb.UUID{GoUUID: uuid}
 }
@@ -446,7 +530,7 @@ func (odbi *ovndb) auxKeyValDel(table string, rowName string, auxCol string, kv
 
 	ovnRow := make(OVNRow)
 	ovnRow["name"] = rowName
-	uuid := odbi.getRowUUID(TableLogicalSwitch, ovnRow)
+	uuid := odbi.getRowUUID(table, ovnRow)
 	if len(uuid) == 0 {
 		return nil, ErrorNotFound
 	}
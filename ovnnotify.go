@@ -37,7 +37,13 @@ func (notify ovnNotifier) Echo([]interface{}) {
 func (notify ovnNotifier) Disconnected(client *libovsdb.OvsdbClient) {
 	if notify.odbi.reconn {
 		notify.odbi.reconnect()
-	} else if notify.odbi.disconnectCB != nil {
+		return
+	}
+	// the client is gone for good, the cache will not change anymore
+	if notify.odbi.liveness != nil {
+		notify.odbi.liveness.stop()
+	}
+	if notify.odbi.disconnectCB != nil {
 		notify.odbi.disconnectCB()
 	}
 }
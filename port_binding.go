@@ -0,0 +1,173 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// PortBinding table OVN SB
+type PortBinding struct {
+	UUID        string
+	LogicalPort string
+	Type        string
+	Datapath    string
+	TunnelKey   int
+	// Chassis is the uuid of the chassis the port is bound to, nil if unbound
+	Chassis *string
+	// RequestedChassis is the uuid of the chassis the CMS asked to bind the port
+	RequestedChassis *string
+	Up               bool
+	MAC              []string
+	ParentPort       *string
+	Tag              *int
+	VirtualParent    *string
+	Options          map[interface{}]interface{}
+	ExternalID       map[interface{}]interface{}
+}
+
+func (odbi *ovndb) portBindingGetImp(logicalPort string) (*PortBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	for uuid, drows := range cachePortBinding {
+		if lp, ok := drows.Fields["logical_port"].(string); ok && lp == logicalPort {
+			return odbi.rowToPortBinding(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) portBindingListImp() ([]*PortBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listPortBinding := make([]*PortBinding, 0, len(cachePortBinding))
+	for uuid := range cachePortBinding {
+		listPortBinding = append(listPortBinding, odbi.rowToPortBinding(uuid))
+	}
+	return listPortBinding, nil
+}
+
+// portBindingListByChassisImp lists the ports bound to the chassis with the
+// given name or hostname
+func (odbi *ovndb) portBindingListByChassisImp(chassis string) ([]*PortBinding, error) {
+	chassisList, err := odbi.chassisGetImp(chassis)
+	if err != nil {
+		return nil, err
+	}
+	if len(chassisList) == 0 {
+		return nil, ErrorNotFound
+	}
+	chassisUUIDs := make(map[string]bool, len(chassisList))
+	for _, ch := range chassisList {
+		chassisUUIDs[ch.UUID] = true
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cachePortBinding, ok := odbi.cache[TablePortBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	var listPortBinding []*PortBinding
+	for uuid, drows := range cachePortBinding {
+		if ch := odbi.optionalStringFieldToPointer(drows.Fields["chassis"]); ch != nil && chassisUUIDs[*ch] {
+			listPortBinding = append(listPortBinding, odbi.rowToPortBinding(uuid))
+		}
+	}
+	return listPortBinding, nil
+}
+
+func (odbi *ovndb) rowToPortBinding(uuid string) *PortBinding {
+	cachePortBinding, ok := odbi.cache[TablePortBinding][uuid]
+	if !ok {
+		return nil
+	}
+
+	pb := &PortBinding{
+		UUID:             uuid,
+		LogicalPort:      cachePortBinding.Fields["logical_port"].(string),
+		Type:             cachePortBinding.Fields["type"].(string),
+		Chassis:          odbi.optionalStringFieldToPointer(cachePortBinding.Fields["chassis"]),
+		RequestedChassis: odbi.optionalStringFieldToPointer(cachePortBinding.Fields["requested_chassis"]),
+		ParentPort:       odbi.optionalStringFieldToPointer(cachePortBinding.Fields["parent_port"]),
+		Tag:              odbi.optionalIntFieldToPointer(cachePortBinding.Fields["tag"]),
+		VirtualParent:    odbi.optionalStringFieldToPointer(cachePortBinding.Fields["virtual_parent"]),
+		Options:          cachePortBinding.Fields["options"].(libovsdb.OvsMap).GoMap,
+		ExternalID:       cachePortBinding.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if datapath, ok := cachePortBinding.Fields["datapath"].(libovsdb.UUID); ok {
+		pb.Datapath = datapath.GoUUID
+	}
+	if tunnelKey, ok := cachePortBinding.Fields["tunnel_key"].(int); ok {
+		pb.TunnelKey = tunnelKey
+	}
+	switch up := cachePortBinding.Fields["up"].(type) {
+	case bool:
+		pb.Up = up
+	case libovsdb.OvsSet:
+		for _, v := range up.GoSet {
+			if b, ok := v.(bool); ok {
+				pb.Up = b
+			}
+		}
+	}
+	switch mac := cachePortBinding.Fields["mac"].(type) {
+	case string:
+		pb.MAC = []string{mac}
+	case libovsdb.OvsSet:
+		pb.MAC = odbi.ConvertGoSetToStringArray(mac)
+	}
+	return pb
+}
+
+// signalPortBindingUpdate notifies bind/unbind when the chassis of the port
+// binding uuid changed from what it was in old, caller must hold cachemutex
+func (odbi *ovndb) signalPortBindingUpdate(uuid string, old libovsdb.Row) {
+	pb := odbi.rowToPortBinding(uuid)
+	if pb == nil {
+		return
+	}
+	var oldChassis *string
+	if old.Fields != nil {
+		oldChassis = odbi.optionalStringFieldToPointer(old.Fields["chassis"])
+	}
+	if oldChassis != nil && pb.Chassis != nil && *oldChassis == *pb.Chassis {
+		return
+	}
+	if oldChassis != nil {
+		unbound := *pb
+		unbound.Chassis = oldChassis
+		odbi.signalCB.OnPortBindingUnbind(&unbound)
+	}
+	if pb.Chassis != nil {
+		odbi.signalCB.OnPortBindingBind(pb)
+	}
+}
@@ -0,0 +1,202 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	PB_LSP1     = "pb-lsp1"
+	PB_LSP2     = "pb-lsp2"
+	PB_DP_KEY   = 4001
+	PB_DP_LS_ID = "5b1e7a56-0c2a-4f4e-9d1e-6f4c7e0a0001"
+)
+
+// sbTestDatapathAdd returns a command inserting a Datapath_Binding row the way
+// ovn-northd would, so that south bound reads can be tested without northd.
+// The returned named uuid can be used to reference the row in the same
+// transaction.
+func sbTestDatapathAdd(t *testing.T, ovndbapi Client, tunnelKey int, external_ids map[string]string) (*OvnCommand, string) {
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := make(OVNRow)
+	row["tunnel_key"] = tunnelKey
+	oMap, err := libovsdb.NewOvsMap(external_ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row["external_ids"] = oMap
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableDatapathBinding,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}, namedUUID
+}
+
+// sbTestPortBindingAdd returns a command inserting a Port_Binding row of
+// datapath, bound to the chassis uuid unless it is empty
+func sbTestPortBindingAdd(t *testing.T, ovndbapi Client, datapath string, logicalPort string, tunnelKey int, chassis string) *OvnCommand {
+	row := make(OVNRow)
+	row["logical_port"] = logicalPort
+	row["datapath"] = stringToGoUUID(datapath)
+	row["tunnel_key"] = tunnelKey
+	if len(chassis) > 0 {
+		row["chassis"] = stringToGoUUID(chassis)
+	}
+	insertOp := libovsdb.Operation{
+		Op:    opInsert,
+		Table: TablePortBinding,
+		Row:   row,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+}
+
+// sbTestPortBindingSetChassis returns a command binding logicalPort to the
+// chassis uuid, or unbinding it if chassis is empty
+func sbTestPortBindingSetChassis(t *testing.T, ovndbapi Client, logicalPort string, chassis string) *OvnCommand {
+	row := make(OVNRow)
+	if len(chassis) > 0 {
+		row["chassis"] = stringToGoUUID(chassis)
+	} else {
+		emptySet, err := libovsdb.NewOvsSet([]libovsdb.UUID{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		row["chassis"] = emptySet
+	}
+	condition := libovsdb.NewCondition("logical_port", "==", logicalPort)
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TablePortBinding,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+}
+
+// sbTestDatapathDel returns a command deleting the Datapath_Binding with the
+// given uuid together with its port bindings
+func sbTestDatapathDel(t *testing.T, ovndbapi Client, datapath string) *OvnCommand {
+	var operations []libovsdb.Operation
+	pbs, err := ovndbapi.PortBindingList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pb := range pbs {
+		if pb.Datapath == datapath {
+			condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(pb.UUID))
+			operations = append(operations, libovsdb.Operation{
+				Op:    opDelete,
+				Table: TablePortBinding,
+				Where: []interface{}{condition},
+			})
+		}
+	}
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(datapath))
+	operations = append(operations, libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableDatapathBinding,
+		Where: []interface{}{condition},
+	})
+	return &OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))}
+}
+
+func TestPortBinding(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	cmd, err := ovndbapi.ChassisAdd(CHASSIS_NAME, CHASSIS_HOSTNAME, ENCAP_TYPES, IP, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chassis, err := ovndbapi.ChassisGet(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chassisUUID := chassis[0].UUID
+
+	dpCmd, dp := sbTestDatapathAdd(t, ovndbapi, PB_DP_KEY, map[string]string{"logical-switch": PB_DP_LS_ID})
+	pbCmd1 := sbTestPortBindingAdd(t, ovndbapi, dp, PB_LSP1, 1, chassisUUID)
+	pbCmd2 := sbTestPortBindingAdd(t, ovndbapi, dp, PB_LSP2, 2, "")
+	uuids, err := ovndbapi.ExecuteR(dpCmd, pbCmd1, pbCmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpUUID := uuids[0]
+
+	pb, err := ovndbapi.PortBindingGet(PB_LSP1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, pb.TunnelKey)
+	if assert.NotNil(t, pb.Chassis) {
+		assert.Equal(t, chassisUUID, *pb.Chassis)
+	}
+	pb, err = ovndbapi.PortBindingGet(PB_LSP2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, pb.Chassis)
+	_, err = ovndbapi.PortBindingGet(FAKENOSWITCH)
+	assert.Equal(t, ErrorNotFound, err)
+
+	pbs, err := ovndbapi.PortBindingListByChassis(CHASSIS_HOSTNAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(pbs))
+	assert.Equal(t, PB_LSP1, pbs[0].LogicalPort)
+
+	err = ovndbapi.Execute(sbTestPortBindingSetChassis(t, ovndbapi, PB_LSP2, chassisUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbs, err = ovndbapi.PortBindingListByChassis(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(pbs))
+
+	err = ovndbapi.Execute(sbTestDatapathDel(t, ovndbapi, dpUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.PortBindingGet(PB_LSP1)
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err = ovndbapi.ChassisDel(CHASSIS_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
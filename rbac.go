@@ -0,0 +1,437 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ebay/libovsdb"
+)
+
+// RBACControllerRole is the role ovn-controller connects with
+const RBACControllerRole = "ovn-controller"
+
+// RBACRole table OVN SB
+type RBACRole struct {
+	UUID string
+	Name string
+	// Permissions maps a table name to the uuid of its RBAC_Permission row
+	Permissions map[string]string
+}
+
+// RBACPermission table OVN SB
+type RBACPermission struct {
+	UUID          string
+	Table         string
+	Authorization []string
+	InsertDelete  bool
+	Update        []string
+}
+
+// rbacControllerPermissions are the permissions ovn-northd installs for the
+// ovn-controller role, as of OVN 23.09. Tables and columns missing from older
+// schemas are left out when installing, see rbacSchemaPermission.
+var rbacControllerPermissions = []RBACPermission{
+	{Table: TableChassis, Authorization: []string{"name"}, InsertDelete: true,
+		Update: []string{"nb_cfg", "external_ids", "encaps", "vtep_logical_switches", "other_config", "transport_zones"}},
+	{Table: TableChassisPrivate, Authorization: []string{"name"}, InsertDelete: true,
+		Update: []string{"nb_cfg", "nb_cfg_timestamp", "chassis", "external_ids"}},
+	{Table: TableEncap, Authorization: []string{"chassis_name"}, InsertDelete: true,
+		Update: []string{"type", "options", "ip"}},
+	{Table: TableControllerEvent, Authorization: []string{""}, InsertDelete: true,
+		Update: []string{"chassis", "event_info", "event_type", "seq_num"}},
+	{Table: TableFDB, Authorization: []string{""}, InsertDelete: true,
+		Update: []string{"dp_key", "mac", "port_key", "timestamp"}},
+	{Table: TablePortBinding, Authorization: []string{""}, InsertDelete: false,
+		Update: []string{"chassis", "additional_chassis", "encap", "additional_encap", "up", "virtual_parent"}},
+	{Table: TableMACBinding, Authorization: []string{""}, InsertDelete: true,
+		Update: []string{"logical_port", "ip", "mac", "datapath", "timestamp"}},
+	{Table: TableServiceMonitor, Authorization: []string{""}, InsertDelete: false,
+		Update: []string{"status", "chassis_name"}},
+	{Table: TableIGMPGroup, Authorization: []string{""}, InsertDelete: true,
+		Update: []string{"address", "chassis", "datapath", "ports"}},
+	{Table: TableBFD, Authorization: []string{""}, InsertDelete: false,
+		Update: []string{"status"}},
+}
+
+// rbacSchemaPermission returns perm restricted to the update columns present
+// in the schema, nil if the schema lacks perm.Table
+func rbacSchemaPermission(schema libovsdb.DatabaseSchema, perm *RBACPermission) *RBACPermission {
+	tableSchema, ok := schema.Tables[perm.Table]
+	if !ok {
+		return nil
+	}
+	filtered := *perm
+	filtered.Update = make([]string, 0, len(perm.Update))
+	for _, column := range perm.Update {
+		if _, ok := tableSchema.Columns[column]; ok {
+			filtered.Update = append(filtered.Update, column)
+		}
+	}
+	return &filtered
+}
+
+// rbacRoleUUID returns the uuid of role, caller must hold cachemutex
+func (odbi *ovndb) rbacRoleUUID(role string) string {
+	for uuid, drows := range odbi.cache[TableRBACRole] {
+		if name, ok := drows.Fields["name"].(string); ok && name == role {
+			return uuid
+		}
+	}
+	return ""
+}
+
+// rbacPermissionOps returns the operations setting perm as the permission of
+// role for perm.Table, replacing the permission row oldUUID if not empty
+func rbacPermissionOps(role string, perm *RBACPermission, oldUUID string) ([]libovsdb.Operation, error) {
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["table"] = perm.Table
+	auth, err := libovsdb.NewOvsSet(perm.Authorization)
+	if err != nil {
+		return nil, err
+	}
+	row["authorization"] = auth
+	row["insert_delete"] = perm.InsertDelete
+	update, err := libovsdb.NewOvsSet(perm.Update)
+	if err != nil {
+		return nil, err
+	}
+	row["update"] = update
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableRBACPermission,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+
+	delKeys, err := libovsdb.NewOvsSet([]string{perm.Table})
+	if err != nil {
+		return nil, err
+	}
+	newEntry, err := libovsdb.NewOvsMap(map[string]libovsdb.UUID{perm.Table: stringToGoUUID(namedUUID)})
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("name", "==", role)
+	// mutations of one operation are applied in order, drop the old key first
+	mutateOp := libovsdb.Operation{
+		Op:    opMutate,
+		Table: TableRBACRole,
+		Mutations: []interface{}{
+			libovsdb.NewMutation("permissions", opDelete, delKeys),
+			libovsdb.NewMutation("permissions", opInsert, newEntry),
+		},
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{insertOp, mutateOp}
+	if len(oldUUID) > 0 {
+		delCondition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(oldUUID))
+		operations = append(operations, libovsdb.Operation{
+			Op:    opDelete,
+			Table: TableRBACPermission,
+			Where: []interface{}{delCondition},
+		})
+	}
+	return operations, nil
+}
+
+func (odbi *ovndb) rbacRoleAddImp(role string) (*OvnCommand, error) {
+	if len(role) == 0 {
+		return nil, fmt.Errorf("rbac role name cannot be empty")
+	}
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableRBACRole]; !ok {
+		return nil, ErrorSchema
+	}
+	if len(odbi.rbacRoleUUID(role)) > 0 {
+		return nil, ErrorExist
+	}
+
+	namedUUID, err := newRowUUID()
+	if err != nil {
+		return nil, err
+	}
+	row := make(OVNRow)
+	row["name"] = role
+	insertOp := libovsdb.Operation{
+		Op:       opInsert,
+		Table:    TableRBACRole,
+		Row:      row,
+		UUIDName: namedUUID,
+	}
+	operations := []libovsdb.Operation{insertOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// rbacRoleDelImp deletes role along with its permissions
+func (odbi *ovndb) rbacRoleDelImp(role string) (*OvnCommand, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	roleUUID := odbi.rbacRoleUUID(role)
+	if len(roleUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(roleUUID))
+	operations := []libovsdb.Operation{{
+		Op:    opDelete,
+		Table: TableRBACRole,
+		Where: []interface{}{condition},
+	}}
+	for _, permUUID := range odbi.rowToRBACRole(roleUUID).Permissions {
+		permCondition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(permUUID))
+		operations = append(operations, libovsdb.Operation{
+			Op:    opDelete,
+			Table: TableRBACPermission,
+			Where: []interface{}{permCondition},
+		})
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rbacRoleGetImp(role string) (*RBACRole, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableRBACRole]; !ok {
+		return nil, ErrorSchema
+	}
+	roleUUID := odbi.rbacRoleUUID(role)
+	if len(roleUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.rowToRBACRole(roleUUID), nil
+}
+
+func (odbi *ovndb) rbacRoleListImp() ([]*RBACRole, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheRole, ok := odbi.cache[TableRBACRole]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listRole := make([]*RBACRole, 0, len(cacheRole))
+	for uuid := range cacheRole {
+		listRole = append(listRole, odbi.rowToRBACRole(uuid))
+	}
+	return listRole, nil
+}
+
+// rbacPermissionSetImp sets perm as the permission of role on perm.Table,
+// replacing the existing one
+func (odbi *ovndb) rbacPermissionSetImp(role string, perm *RBACPermission) (*OvnCommand, error) {
+	if perm == nil || len(perm.Table) == 0 {
+		return nil, fmt.Errorf("rbac permission table cannot be empty")
+	}
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	roleUUID := odbi.rbacRoleUUID(role)
+	if len(roleUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	operations, err := rbacPermissionOps(role, perm, odbi.rowToRBACRole(roleUUID).Permissions[perm.Table])
+	if err != nil {
+		return nil, err
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rbacPermissionDelImp(role string, table string) (*OvnCommand, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	roleUUID := odbi.rbacRoleUUID(role)
+	if len(roleUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+	permUUID, ok := odbi.rowToRBACRole(roleUUID).Permissions[table]
+	if !ok {
+		return nil, ErrorNotFound
+	}
+
+	delKeys, err := libovsdb.NewOvsSet([]string{table})
+	if err != nil {
+		return nil, err
+	}
+	condition := libovsdb.NewCondition("name", "==", role)
+	mutateOp := libovsdb.Operation{
+		Op:        opMutate,
+		Table:     TableRBACRole,
+		Mutations: []interface{}{libovsdb.NewMutation("permissions", opDelete, delKeys)},
+		Where:     []interface{}{condition},
+	}
+	permCondition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(permUUID))
+	deleteOp := libovsdb.Operation{
+		Op:    opDelete,
+		Table: TableRBACPermission,
+		Where: []interface{}{permCondition},
+	}
+	operations := []libovsdb.Operation{mutateOp, deleteOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rbacPermissionListImp(role string) ([]*RBACPermission, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	roleUUID := odbi.rbacRoleUUID(role)
+	if len(roleUUID) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	var listPerm []*RBACPermission
+	for _, permUUID := range odbi.rowToRBACRole(roleUUID).Permissions {
+		if perm := odbi.rowToRBACPermission(permUUID); perm != nil {
+			listPerm = append(listPerm, perm)
+		}
+	}
+	return listPerm, nil
+}
+
+// sameStringSet tells if a and b hold the same strings, ignoring order
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string(nil), a...)
+	sb := append([]string(nil), b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// rbacInstallControllerRoleImp creates the ovn-controller role with the
+// standard permissions, or fixes the permissions that differ from them.
+// ErrorNoChanges is returned when the role is already up to date.
+func (odbi *ovndb) rbacInstallControllerRoleImp() (*OvnCommand, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableRBACRole]; !ok {
+		return nil, ErrorSchema
+	}
+
+	var operations []libovsdb.Operation
+	current := make(map[string]string)
+	if roleUUID := odbi.rbacRoleUUID(RBACControllerRole); len(roleUUID) > 0 {
+		current = odbi.rowToRBACRole(roleUUID).Permissions
+	} else {
+		namedUUID, err := newRowUUID()
+		if err != nil {
+			return nil, err
+		}
+		row := make(OVNRow)
+		row["name"] = RBACControllerRole
+		operations = append(operations, libovsdb.Operation{
+			Op:       opInsert,
+			Table:    TableRBACRole,
+			Row:      row,
+			UUIDName: namedUUID,
+		})
+	}
+
+	schema := odbi.GetSchema()
+	for i := range rbacControllerPermissions {
+		want := rbacSchemaPermission(schema, &rbacControllerPermissions[i])
+		if want == nil {
+			continue
+		}
+		permUUID := current[want.Table]
+		if have := odbi.rowToRBACPermission(permUUID); have != nil &&
+			have.InsertDelete == want.InsertDelete &&
+			sameStringSet(have.Authorization, want.Authorization) &&
+			sameStringSet(have.Update, want.Update) {
+			continue
+		}
+		ops, err := rbacPermissionOps(RBACControllerRole, want, permUUID)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, ops...)
+	}
+	if len(operations) == 0 {
+		return nil, ErrorNoChanges
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) rowToRBACRole(uuid string) *RBACRole {
+	cacheRole, ok := odbi.cache[TableRBACRole][uuid]
+	if !ok {
+		return nil
+	}
+
+	role := &RBACRole{
+		UUID:        uuid,
+		Name:        cacheRole.Fields["name"].(string),
+		Permissions: make(map[string]string),
+	}
+	if perms, ok := cacheRole.Fields["permissions"].(libovsdb.OvsMap); ok {
+		for k, v := range perms.GoMap {
+			table, tableOk := k.(string)
+			permUUID, uuidOk := v.(libovsdb.UUID)
+			if tableOk && uuidOk {
+				role.Permissions[table] = permUUID.GoUUID
+			}
+		}
+	}
+	return role
+}
+
+func (odbi *ovndb) rowToRBACPermission(uuid string) *RBACPermission {
+	cachePerm, ok := odbi.cache[TableRBACPermission][uuid]
+	if !ok {
+		return nil
+	}
+
+	perm := &RBACPermission{
+		UUID:  uuid,
+		Table: cachePerm.Fields["table"].(string),
+	}
+	if insertDelete, ok := cachePerm.Fields["insert_delete"].(bool); ok {
+		perm.InsertDelete = insertDelete
+	}
+	switch auth := cachePerm.Fields["authorization"].(type) {
+	case string:
+		perm.Authorization = []string{auth}
+	case libovsdb.OvsSet:
+		perm.Authorization = odbi.ConvertGoSetToStringArray(auth)
+	}
+	switch update := cachePerm.Fields["update"].(type) {
+	case string:
+		perm.Update = []string{update}
+	case libovsdb.OvsSet:
+		perm.Update = odbi.ConvertGoSetToStringArray(update)
+	}
+	return perm
+}
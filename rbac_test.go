@@ -0,0 +1,159 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const RBAC_ROLE = "goovn-test-role"
+
+func TestRBAC(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	_, err := ovndbapi.RBACRoleAdd("")
+	assert.Error(t, err)
+	cmd, err := ovndbapi.RBACRoleAdd(RBAC_ROLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.RBACRoleAdd(RBAC_ROLE)
+	assert.Equal(t, ErrorExist, err)
+
+	perm := &RBACPermission{
+		Table:         TableChassis,
+		Authorization: []string{"name"},
+		InsertDelete:  true,
+		Update:        []string{"nb_cfg", "external_ids"},
+	}
+	cmd, err = ovndbapi.RBACPermissionSet(RBAC_ROLE, perm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	perms, err := ovndbapi.RBACPermissionList(RBAC_ROLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(perms))
+	assert.Equal(t, TableChassis, perms[0].Table)
+	assert.Equal(t, []string{"name"}, perms[0].Authorization)
+	assert.ElementsMatch(t, []string{"nb_cfg", "external_ids"}, perms[0].Update)
+	assert.True(t, perms[0].InsertDelete)
+
+	// setting the same table again replaces the permission
+	perm.InsertDelete = false
+	perm.Update = []string{"nb_cfg"}
+	cmd, err = ovndbapi.RBACPermissionSet(RBAC_ROLE, perm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	role, err := ovndbapi.RBACRoleGet(RBAC_ROLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(role.Permissions))
+	perms, err = ovndbapi.RBACPermissionList(RBAC_ROLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(perms))
+	assert.Equal(t, role.Permissions[TableChassis], perms[0].UUID)
+	assert.False(t, perms[0].InsertDelete)
+	assert.Equal(t, []string{"nb_cfg"}, perms[0].Update)
+
+	cmd, err = ovndbapi.RBACPermissionDel(RBAC_ROLE, TableChassis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.RBACPermissionDel(RBAC_ROLE, TableChassis)
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err = ovndbapi.RBACRoleDel(RBAC_ROLE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.RBACRoleGet(RBAC_ROLE)
+	assert.Equal(t, ErrorNotFound, err)
+}
+
+func TestRBACInstallControllerRole(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	// ovn-northd may have installed the role already
+	_, err := ovndbapi.RBACRoleGet(RBACControllerRole)
+	preinstalled := err == nil
+
+	cmd, err := ovndbapi.RBACInstallControllerRole()
+	if err == nil {
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+	} else if err != ErrorNoChanges {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.RBACInstallControllerRole()
+	assert.Equal(t, ErrorNoChanges, err)
+
+	perms, err := ovndbapi.RBACPermissionList(RBACControllerRole)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := ovndbapi.GetSchema()
+	tables := make([]string, 0, len(perms))
+	for _, p := range perms {
+		tables = append(tables, p.Table)
+		// columns the schema lacks are never installed
+		for _, column := range p.Update {
+			assert.Contains(t, schema.Tables[p.Table].Columns, column)
+		}
+	}
+	assert.Contains(t, tables, TableChassis)
+	assert.Contains(t, tables, TableEncap)
+	assert.Contains(t, tables, TablePortBinding)
+
+	if !preinstalled {
+		cmd, err = ovndbapi.RBACRoleDel(RBACControllerRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ovndbapi.Execute(cmd)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// SBDNS is a DNS row of OVN SB, ovn-northd sets external_ids:dns_id to the
+// uuid of the north bound DNS row
+type SBDNS struct {
+	UUID    string
+	Records map[interface{}]interface{}
+	// Datapaths are the uuids of the Datapath_Binding rows of the switches using the records
+	Datapaths  []string
+	Options    map[interface{}]interface{}
+	ExternalID map[interface{}]interface{}
+}
+
+// sbDNSListImp lists the DNS rows of datapath, given by uuid or name, or all
+// of them when datapath is empty
+func (odbi *ovndb) sbDNSListImp(datapath string) ([]*SBDNS, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheDNS, ok := odbi.cache[TableDNS]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	var datapaths map[string]bool
+	if len(datapath) > 0 {
+		var err error
+		datapaths, err = odbi.datapathBindingResolve(datapath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listDNS := make([]*SBDNS, 0, len(cacheDNS))
+	for uuid := range cacheDNS {
+		dns := odbi.rowToSBDNS(uuid)
+		if datapaths != nil && !anyDatapathIn(dns.Datapaths, datapaths) {
+			continue
+		}
+		listDNS = append(listDNS, dns)
+	}
+	return listDNS, nil
+}
+
+func (odbi *ovndb) rowToSBDNS(uuid string) *SBDNS {
+	cacheDNS, ok := odbi.cache[TableDNS][uuid]
+	if !ok {
+		return nil
+	}
+
+	dns := &SBDNS{
+		UUID:       uuid,
+		Records:    cacheDNS.Fields["records"].(libovsdb.OvsMap).GoMap,
+		ExternalID: cacheDNS.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if options, ok := cacheDNS.Fields["options"].(libovsdb.OvsMap); ok {
+		dns.Options = options.GoMap
+	}
+	switch datapaths := cacheDNS.Fields["datapaths"].(type) {
+	case libovsdb.UUID:
+		dns.Datapaths = []string{datapaths.GoUUID}
+	case libovsdb.OvsSet:
+		dns.Datapaths = odbi.ConvertGoSetToStringArray(datapaths)
+	}
+	return dns
+}
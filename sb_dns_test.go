@@ -0,0 +1,74 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	SBDNS_DP_KEY = 4701
+	SBDNS_DP     = "sbdns-ls"
+)
+
+func TestSBDNS(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	cmd, dpNamedUUID := sbTestDatapathAdd(t, ovndbapi, SBDNS_DP_KEY, map[string]string{"name": SBDNS_DP})
+	records, err := libovsdb.NewOvsMap(map[string]string{"vm1.ovn.org": "10.0.0.4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	extIDs, err := libovsdb.NewOvsMap(map[string]string{"dns_id": NONEXISTENT_UUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	datapaths, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(dpNamedUUID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := OVNRow{"records": records, "external_ids": extIDs, "datapaths": datapaths}
+	cmd.Operations = append(cmd.Operations, libovsdb.Operation{Op: opInsert, Table: TableDNS, Row: row})
+	cmd.Results = make([][]map[string]interface{}, len(cmd.Operations))
+	uuids, err := ovndbapi.ExecuteR(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listDNS, err := ovndbapi.SBDNSList(uuids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(listDNS))
+	assert.Equal(t, "10.0.0.4", listDNS[0].Records["vm1.ovn.org"])
+	assert.Equal(t, NONEXISTENT_UUID, listDNS[0].ExternalID["dns_id"])
+	assert.Equal(t, []string{uuids[0]}, listDNS[0].Datapaths)
+
+	// DNS references its datapaths strongly, delete it first
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuids[1]))
+	operations := []libovsdb.Operation{{Op: opDelete, Table: TableDNS, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(sbTestDatapathDel(t, ovndbapi, uuids[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
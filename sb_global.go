@@ -16,8 +16,14 @@
 
 package goovn
 
+import (
+	"fmt"
+)
+
 type SBGlobalTableRow struct {
-	UUID        string
+	UUID string
+	// NbCfg is copied by ovn-northd from NB_Global
+	NbCfg       int
 	Options     map[interface{}]interface{}
 	ExternalID  map[interface{}]interface{}
 	Connections []string
@@ -43,3 +49,32 @@ func (odbi *ovndb) sbGlobalSetOptionsImp(options map[string]string) (*OvnCommand
 func (odbi *ovndb) sbGlobalGetOptionsImp() (map[string]string, error) {
 	return odbi.globalGetOptionsImp(TableSBGlobal)
 }
+
+func (odbi *ovndb) sbGlobalGetImp() (*SBGlobalTableRow, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheGlobal, ok := odbi.cache[TableSBGlobal]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	for uuid, drows := range cacheGlobal {
+		row := &SBGlobalTableRow{UUID: uuid}
+		row.Options, row.ExternalID, row.Connections, row.SSL, row.IPSec = odbi.globalRowCommon(drows.Fields)
+		row.NbCfg, _ = drows.Fields["nb_cfg"].(int)
+		return row, nil
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) sbGlobalSetExternalIDsImp(external_ids map[string]string) (*OvnCommand, error) {
+	return odbi.globalMergeMapImp(TableSBGlobal, "external_ids", external_ids)
+}
+
+// sbGlobalSetOptionImp sets a single option, keeping the others
+func (odbi *ovndb) sbGlobalSetOptionImp(key string, value string) (*OvnCommand, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("option key cannot be empty")
+	}
+	return odbi.globalMergeMapImp(TableSBGlobal, "options", map[string]string{key: value})
+}
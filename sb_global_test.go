@@ -83,3 +83,48 @@ func TestSBGlobalAPI(t *testing.T) {
 	err = ovndbapi.Execute(cmd)
 	assert.Equal(t, err == nil, true)
 }
+
+func TestSBGlobalRow(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	ovn := ovndbapi.(*ovndb)
+	cmd, err := ovn.sbGlobalAdd(map[string]string{SB_GLOBAL_DUMMY_OPT_KEY: SB_GLOBAL_DUMMY_OPT_VAL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, err = ovndbapi.SBGlobalSetOption(SB_GLOBAL_OPTIONS_1_KEY, SB_GLOBAL_OPTIONS_1_VAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.SBGlobalSetExternalIDs(map[string]string{"owner": "goovn"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row, err := ovndbapi.SBGlobalGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, SB_GLOBAL_DUMMY_OPT_VAL, row.Options[SB_GLOBAL_DUMMY_OPT_KEY])
+	assert.Equal(t, SB_GLOBAL_OPTIONS_1_VAL, row.Options[SB_GLOBAL_OPTIONS_1_KEY])
+	assert.Equal(t, "goovn", row.ExternalID["owner"])
+	assert.Equal(t, 0, row.NbCfg)
+	assert.False(t, row.IPSec)
+
+	cmd, err = ovn.sbGlobalDel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
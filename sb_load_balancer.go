@@ -0,0 +1,106 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// SBLoadBalancer is a Load_Balancer row of OVN SB, as propagated by
+// ovn-northd from the north bound Load_Balancer of the same name
+type SBLoadBalancer struct {
+	UUID     string
+	Name     string
+	VIPs     map[interface{}]interface{}
+	Protocol *string
+	// Datapaths are the uuids of the Datapath_Binding rows the load balancer
+	// applies to, including the ones of its datapath groups
+	Datapaths  []string
+	Options    map[interface{}]interface{}
+	ExternalID map[interface{}]interface{}
+}
+
+// sbLoadBalancerListImp lists the load balancers of datapath, given by uuid
+// or name, or all of them when datapath is empty
+func (odbi *ovndb) sbLoadBalancerListImp(datapath string) ([]*SBLoadBalancer, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheLB, ok := odbi.cache[TableLoadBalancer]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	var datapaths map[string]bool
+	if len(datapath) > 0 {
+		var err error
+		datapaths, err = odbi.datapathBindingResolve(datapath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listLB := make([]*SBLoadBalancer, 0, len(cacheLB))
+	for uuid := range cacheLB {
+		lb := odbi.rowToSBLoadBalancer(uuid)
+		if datapaths != nil && !anyDatapathIn(lb.Datapaths, datapaths) {
+			continue
+		}
+		listLB = append(listLB, lb)
+	}
+	return listLB, nil
+}
+
+// anyDatapathIn tells if one of uuids is in datapaths
+func anyDatapathIn(uuids []string, datapaths map[string]bool) bool {
+	for _, uuid := range uuids {
+		if datapaths[uuid] {
+			return true
+		}
+	}
+	return false
+}
+
+func (odbi *ovndb) rowToSBLoadBalancer(uuid string) *SBLoadBalancer {
+	cacheLB, ok := odbi.cache[TableLoadBalancer][uuid]
+	if !ok {
+		return nil
+	}
+
+	lb := &SBLoadBalancer{
+		UUID:       uuid,
+		Name:       cacheLB.Fields["name"].(string),
+		VIPs:       cacheLB.Fields["vips"].(libovsdb.OvsMap).GoMap,
+		Protocol:   odbi.optionalStringFieldToPointer(cacheLB.Fields["protocol"]),
+		ExternalID: cacheLB.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if options, ok := cacheLB.Fields["options"].(libovsdb.OvsMap); ok {
+		lb.Options = options.GoMap
+	}
+	switch datapaths := cacheLB.Fields["datapaths"].(type) {
+	case libovsdb.UUID:
+		lb.Datapaths = []string{datapaths.GoUUID}
+	case libovsdb.OvsSet:
+		lb.Datapaths = odbi.ConvertGoSetToStringArray(datapaths)
+	}
+	// newer schemas split the datapaths by type into datapath groups
+	for _, col := range []string{"datapath_group", "ls_datapath_group", "lr_datapath_group"} {
+		if group := odbi.optionalStringFieldToPointer(cacheLB.Fields[col]); group != nil {
+			lb.Datapaths = append(lb.Datapaths, odbi.logicalDPGroupDatapaths(*group)...)
+		}
+	}
+	return lb
+}
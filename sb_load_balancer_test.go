@@ -0,0 +1,73 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	SBLB_DP_KEY = 4601
+	SBLB_DP     = "sblb-ls"
+	SBLB_NAME   = "sblb"
+)
+
+func TestSBLoadBalancer(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	cmd, dpNamedUUID := sbTestDatapathAdd(t, ovndbapi, SBLB_DP_KEY, map[string]string{"name": SBLB_DP})
+	vips, err := libovsdb.NewOvsMap(map[string]string{"10.0.0.10:80": "10.0.0.2:8080,10.0.0.3:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	datapaths, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(dpNamedUUID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := OVNRow{"name": SBLB_NAME, "vips": vips, "protocol": "tcp", "datapaths": datapaths}
+	cmd.Operations = append(cmd.Operations, libovsdb.Operation{Op: opInsert, Table: TableLoadBalancer, Row: row})
+	cmd.Results = make([][]map[string]interface{}, len(cmd.Operations))
+	uuids, err := ovndbapi.ExecuteR(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lbs, err := ovndbapi.SBLoadBalancerList(SBLB_DP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(lbs))
+	assert.Equal(t, SBLB_NAME, lbs[0].Name)
+	assert.Equal(t, "tcp", *lbs[0].Protocol)
+	assert.Equal(t, []string{uuids[0]}, lbs[0].Datapaths)
+	assert.Equal(t, "10.0.0.2:8080,10.0.0.3:8080", lbs[0].VIPs["10.0.0.10:80"])
+	_, err = ovndbapi.SBLoadBalancerList(FAKENOSWITCH)
+	assert.Equal(t, ErrorNotFound, err)
+
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuids[1]))
+	operations := []libovsdb.Operation{{Op: opDelete, Table: TableLoadBalancer, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(sbTestDatapathDel(t, ovndbapi, uuids[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,102 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"github.com/ebay/libovsdb"
+)
+
+// SBMeter is a Meter row of OVN SB, as propagated by ovn-northd
+type SBMeter struct {
+	UUID  string
+	Name  string
+	Unit  string
+	Bands []*SBMeterBand
+}
+
+// SBMeterBand is a Meter_Band row of OVN SB
+type SBMeterBand struct {
+	UUID      string
+	Action    string
+	Rate      int
+	BurstSize int
+}
+
+func (odbi *ovndb) sbMeterListImp() ([]*SBMeter, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheMeter, ok := odbi.cache[TableMeter]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listMeter := make([]*SBMeter, 0, len(cacheMeter))
+	for uuid := range cacheMeter {
+		listMeter = append(listMeter, odbi.rowToSBMeter(uuid))
+	}
+	return listMeter, nil
+}
+
+func (odbi *ovndb) sbMeterGetImp(name string) (*SBMeter, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheMeter, ok := odbi.cache[TableMeter]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	for uuid, drows := range cacheMeter {
+		if meterName, ok := drows.Fields["name"].(string); ok && meterName == name {
+			return odbi.rowToSBMeter(uuid), nil
+		}
+	}
+	return nil, ErrorNotFound
+}
+
+func (odbi *ovndb) rowToSBMeter(uuid string) *SBMeter {
+	cacheMeter, ok := odbi.cache[TableMeter][uuid]
+	if !ok {
+		return nil
+	}
+
+	meter := &SBMeter{
+		UUID: uuid,
+		Name: cacheMeter.Fields["name"].(string),
+		Unit: cacheMeter.Fields["unit"].(string),
+	}
+	var bands []string
+	switch b := cacheMeter.Fields["bands"].(type) {
+	case libovsdb.UUID:
+		bands = []string{b.GoUUID}
+	case libovsdb.OvsSet:
+		bands = odbi.ConvertGoSetToStringArray(b)
+	}
+	for _, bandUUID := range bands {
+		cacheBand, ok := odbi.cache[TableMeterBand][bandUUID]
+		if !ok {
+			continue
+		}
+		meter.Bands = append(meter.Bands, &SBMeterBand{
+			UUID:      bandUUID,
+			Action:    cacheBand.Fields["action"].(string),
+			Rate:      cacheBand.Fields["rate"].(int),
+			BurstSize: cacheBand.Fields["burst_size"].(int),
+		})
+	}
+	return meter
+}
@@ -0,0 +1,71 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const SBMETER = "sbmeter"
+
+func TestSBMeter(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	bandUUID, err := newRowUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bands, err := libovsdb.NewOvsSet([]libovsdb.UUID{stringToGoUUID(bandUUID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	operations := []libovsdb.Operation{
+		{Op: opInsert, Table: TableMeterBand, Row: OVNRow{"action": "drop", "rate": 100, "burst_size": 10}, UUIDName: bandUUID},
+		{Op: opInsert, Table: TableMeter, Row: OVNRow{"name": SBMETER, "unit": "pktps", "bands": bands}},
+	}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meter, err := ovndbapi.SBMeterGet(SBMETER)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "pktps", meter.Unit)
+	assert.Equal(t, 1, len(meter.Bands))
+	assert.Equal(t, "drop", meter.Bands[0].Action)
+	assert.Equal(t, 100, meter.Bands[0].Rate)
+	assert.Equal(t, 10, meter.Bands[0].BurstSize)
+	meters, err := ovndbapi.SBMeterList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, meters, meter)
+	_, err = ovndbapi.SBMeterGet(FOO)
+	assert.Equal(t, ErrorNotFound, err)
+
+	// the band is garbage collected along with the meter
+	condition := libovsdb.NewCondition("name", "==", SBMETER)
+	operations = []libovsdb.Operation{{Op: opDelete, Table: TableMeter, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
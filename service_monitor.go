@@ -0,0 +1,183 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ebay/libovsdb"
+)
+
+// Service_Monitor status values
+const (
+	ServiceMonitorOnline  = "online"
+	ServiceMonitorOffline = "offline"
+	ServiceMonitorError   = "error"
+)
+
+// ServiceMonitor table OVN SB, the health check state of a load balancer backend
+type ServiceMonitor struct {
+	UUID        string
+	IP          string
+	Protocol    *string
+	Port        int
+	LogicalPort string
+	SrcMAC      string
+	SrcIP       string
+	// Status is nil until ovn-controller checked the backend
+	Status     *string
+	Options    map[interface{}]interface{}
+	ExternalID map[interface{}]interface{}
+}
+
+// ServiceMonitorFilter selects service monitors, zero values match everything
+type ServiceMonitorFilter struct {
+	// VIP is a key of the vips of a south bound Load_Balancer, e.g.
+	// "10.0.0.10:80", selecting the monitors of its backends
+	VIP string
+	// Backend is "ip" or "ip:port"
+	Backend     string
+	LogicalPort string
+}
+
+// splitIPPort splits "ip:port", "[ip]:port" or a bare ip, port is -1 when missing
+func splitIPPort(addr string) (string, int) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return strings.Trim(addr, "[]"), -1
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return host, -1
+	}
+	return host, p
+}
+
+// serviceMonitorVIPBackends returns the "ip:port" backends of vip across
+// all load balancers, caller must hold cachemutex
+func (odbi *ovndb) serviceMonitorVIPBackends(vip string) (map[string]bool, error) {
+	cacheLB, ok := odbi.cache[TableLoadBalancer]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	var found bool
+	backends := make(map[string]bool)
+	for _, drows := range cacheLB {
+		vips, ok := drows.Fields["vips"].(libovsdb.OvsMap)
+		if !ok {
+			continue
+		}
+		list, ok := vips.GoMap[vip].(string)
+		if !ok {
+			continue
+		}
+		found = true
+		for _, backend := range strings.Split(list, ",") {
+			ip, port := splitIPPort(strings.TrimSpace(backend))
+			backends[net.JoinHostPort(ip, strconv.Itoa(port))] = true
+		}
+	}
+	if !found {
+		return nil, ErrorNotFound
+	}
+	return backends, nil
+}
+
+func (odbi *ovndb) serviceMonitorListImp(filter *ServiceMonitorFilter) ([]*ServiceMonitor, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheMonitor, ok := odbi.cache[TableServiceMonitor]
+	if !ok {
+		return nil, ErrorSchema
+	}
+	if filter == nil {
+		filter = &ServiceMonitorFilter{}
+	}
+	var backends map[string]bool
+	if len(filter.VIP) > 0 {
+		var err error
+		backends, err = odbi.serviceMonitorVIPBackends(filter.VIP)
+		if err != nil {
+			return nil, err
+		}
+	}
+	backendIP, backendPort := splitIPPort(filter.Backend)
+
+	listMonitor := make([]*ServiceMonitor, 0, len(cacheMonitor))
+	for uuid := range cacheMonitor {
+		sm := odbi.rowToServiceMonitor(uuid)
+		if backends != nil && !backends[net.JoinHostPort(sm.IP, strconv.Itoa(sm.Port))] {
+			continue
+		}
+		if len(filter.Backend) > 0 && (sm.IP != backendIP || (backendPort >= 0 && sm.Port != backendPort)) {
+			continue
+		}
+		if len(filter.LogicalPort) > 0 && sm.LogicalPort != filter.LogicalPort {
+			continue
+		}
+		listMonitor = append(listMonitor, sm)
+	}
+	return listMonitor, nil
+}
+
+// signalServiceMonitorUpdate reports status changes of the service monitor
+// with the given uuid, old is its row before the update
+func (odbi *ovndb) signalServiceMonitorUpdate(uuid string, old libovsdb.Row) {
+	sm := odbi.rowToServiceMonitor(uuid)
+	if sm == nil {
+		return
+	}
+	var oldStatus *string
+	if old.Fields != nil {
+		oldStatus = odbi.optionalStringFieldToPointer(old.Fields["status"])
+	}
+	if oldStatus == nil && sm.Status == nil {
+		return
+	}
+	if oldStatus != nil && sm.Status != nil && *oldStatus == *sm.Status {
+		return
+	}
+	odbi.signalCB.OnServiceMonitorStatusChange(sm, oldStatus)
+}
+
+func (odbi *ovndb) rowToServiceMonitor(uuid string) *ServiceMonitor {
+	cacheMonitor, ok := odbi.cache[TableServiceMonitor][uuid]
+	if !ok {
+		return nil
+	}
+
+	sm := &ServiceMonitor{
+		UUID:        uuid,
+		IP:          cacheMonitor.Fields["ip"].(string),
+		Protocol:    odbi.optionalStringFieldToPointer(cacheMonitor.Fields["protocol"]),
+		Port:        cacheMonitor.Fields["port"].(int),
+		LogicalPort: cacheMonitor.Fields["logical_port"].(string),
+		Status:      odbi.optionalStringFieldToPointer(cacheMonitor.Fields["status"]),
+		Options:     cacheMonitor.Fields["options"].(libovsdb.OvsMap).GoMap,
+		ExternalID:  cacheMonitor.Fields["external_ids"].(libovsdb.OvsMap).GoMap,
+	}
+	if srcMAC, ok := cacheMonitor.Fields["src_mac"].(string); ok {
+		sm.SrcMAC = srcMAC
+	}
+	if srcIP, ok := cacheMonitor.Fields["src_ip"].(string); ok {
+		sm.SrcIP = srcIP
+	}
+	return sm
+}
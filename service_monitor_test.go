@@ -0,0 +1,96 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/ebay/libovsdb"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	SVCMON_LB  = "svcmon-lb"
+	SVCMON_VIP = "10.0.0.20:80"
+	SVCMON_LSP = "svcmon-lsp"
+)
+
+func TestServiceMonitor(t *testing.T) {
+	ovndbapi := getOVNClient(DBSB)
+	vips, err := libovsdb.NewOvsMap(map[string]string{SVCMON_VIP: "10.0.0.5:8080,10.0.0.6:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	operations := []libovsdb.Operation{
+		{Op: opInsert, Table: TableLoadBalancer, Row: OVNRow{"name": SVCMON_LB, "vips": vips, "protocol": "tcp"}},
+		{Op: opInsert, Table: TableServiceMonitor, Row: OVNRow{"ip": "10.0.0.5", "port": 8080, "protocol": "tcp",
+			"logical_port": SVCMON_LSP, "src_mac": "00:00:00:00:00:fe", "src_ip": "10.0.0.254"}},
+		{Op: opInsert, Table: TableServiceMonitor, Row: OVNRow{"ip": "10.0.0.5", "port": 9090, "protocol": "tcp",
+			"logical_port": SVCMON_LSP, "src_mac": "00:00:00:00:00:fe", "src_ip": "10.0.0.254"}},
+	}
+	uuids, err := ovndbapi.ExecuteR(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitors, err := ovndbapi.ServiceMonitorList(&ServiceMonitorFilter{VIP: SVCMON_VIP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(monitors))
+	assert.Equal(t, uuids[1], monitors[0].UUID)
+	assert.Equal(t, 8080, monitors[0].Port)
+	assert.Equal(t, "tcp", *monitors[0].Protocol)
+	assert.Nil(t, monitors[0].Status)
+	_, err = ovndbapi.ServiceMonitorList(&ServiceMonitorFilter{VIP: "10.0.0.21:80"})
+	assert.Equal(t, ErrorNotFound, err)
+
+	monitors, err = ovndbapi.ServiceMonitorList(&ServiceMonitorFilter{Backend: "10.0.0.5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(monitors))
+	monitors, err = ovndbapi.ServiceMonitorList(&ServiceMonitorFilter{Backend: "10.0.0.5:9090", LogicalPort: SVCMON_LSP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(monitors))
+	assert.Equal(t, uuids[2], monitors[0].UUID)
+
+	// ovn-controller reports the backend state
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuids[1]))
+	operations = []libovsdb.Operation{{Op: opUpdate, Table: TableServiceMonitor, Row: OVNRow{"status": ServiceMonitorOffline}, Where: []interface{}{condition}}}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	monitors, err = ovndbapi.ServiceMonitorList(&ServiceMonitorFilter{VIP: SVCMON_VIP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ServiceMonitorOffline, *monitors[0].Status)
+
+	operations = nil
+	for i, table := range []string{TableLoadBalancer, TableServiceMonitor, TableServiceMonitor} {
+		condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuids[i]))
+		operations = append(operations, libovsdb.Operation{Op: opDelete, Table: table, Where: []interface{}{condition}})
+	}
+	err = ovndbapi.Execute(&OvnCommand{operations, ovndbapi.(*ovndb), make([][]map[string]interface{}, len(operations))})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
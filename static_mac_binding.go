@@ -0,0 +1,221 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ebay/libovsdb"
+)
+
+// StaticMACBinding ovnnb item
+type StaticMACBinding struct {
+	UUID               string
+	LogicalPort        string
+	IP                 string
+	MAC                string
+	OverrideDynamicMAC bool
+}
+
+// getStaticMACBindingUUID returns the uuid of the binding of ip on the router
+// port lrp, caller must hold cachemutex
+func (odbi *ovndb) getStaticMACBindingUUID(lrp string, ip string) string {
+	for uuid, drows := range odbi.cache[TableStaticMACBinding] {
+		if drows.Fields["logical_port"] == lrp && drows.Fields["ip"] == ip {
+			return uuid
+		}
+	}
+	return ""
+}
+
+func (odbi *ovndb) validateStaticMACBinding(binding *StaticMACBinding) error {
+	if binding == nil {
+		return fmt.Errorf("static mac binding is nil")
+	}
+	if net.ParseIP(binding.IP) == nil {
+		return fmt.Errorf("invalid ip %s", binding.IP)
+	}
+	if _, err := net.ParseMAC(binding.MAC); err != nil {
+		return fmt.Errorf("invalid mac %s: %s", binding.MAC, err)
+	}
+	row := make(OVNRow)
+	row["name"] = binding.LogicalPort
+	if uuid := odbi.getRowUUID(TableLogicalRouterPort, row); len(uuid) == 0 {
+		return fmt.Errorf("logical router port %s not found", binding.LogicalPort)
+	}
+	return nil
+}
+
+// staticMACBindingAddImp inserts all bindings in a single command
+func (odbi *ovndb) staticMACBindingAddImp(bindings ...*StaticMACBinding) (*OvnCommand, error) {
+	if len(bindings) == 0 {
+		return nil, ErrorOption
+	}
+	for _, binding := range bindings {
+		if err := odbi.validateStaticMACBinding(binding); err != nil {
+			return nil, err
+		}
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableStaticMACBinding]; !ok {
+		return nil, ErrorSchema
+	}
+
+	var operations []libovsdb.Operation
+	seen := make(map[string]bool, len(bindings))
+	for _, binding := range bindings {
+		key := binding.LogicalPort + "/" + binding.IP
+		if seen[key] || len(odbi.getStaticMACBindingUUID(binding.LogicalPort, binding.IP)) > 0 {
+			return nil, ErrorExist
+		}
+		seen[key] = true
+
+		namedUUID, err := newRowUUID()
+		if err != nil {
+			return nil, err
+		}
+		row := make(OVNRow)
+		row["logical_port"] = binding.LogicalPort
+		row["ip"] = binding.IP
+		row["mac"] = binding.MAC
+		row["override_dynamic_mac"] = binding.OverrideDynamicMAC
+		insertOp := libovsdb.Operation{
+			Op:       opInsert,
+			Table:    TableStaticMACBinding,
+			Row:      row,
+			UUIDName: namedUUID,
+		}
+		operations = append(operations, insertOp)
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// staticMACBindingUpdateImp updates mac and override_dynamic_mac of the
+// binding identified by logical port and ip
+func (odbi *ovndb) staticMACBindingUpdateImp(binding *StaticMACBinding) (*OvnCommand, error) {
+	if err := odbi.validateStaticMACBinding(binding); err != nil {
+		return nil, err
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	uuid := odbi.getStaticMACBindingUUID(binding.LogicalPort, binding.IP)
+	if len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+
+	row := make(OVNRow)
+	row["mac"] = binding.MAC
+	row["override_dynamic_mac"] = binding.OverrideDynamicMAC
+	condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+	updateOp := libovsdb.Operation{
+		Op:    opUpdate,
+		Table: TableStaticMACBinding,
+		Row:   row,
+		Where: []interface{}{condition},
+	}
+	operations := []libovsdb.Operation{updateOp}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+// staticMACBindingDelImp deletes all bindings in a single command, bindings
+// are matched on logical port and ip
+func (odbi *ovndb) staticMACBindingDelImp(bindings ...*StaticMACBinding) (*OvnCommand, error) {
+	if len(bindings) == 0 {
+		return nil, ErrorOption
+	}
+
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	var operations []libovsdb.Operation
+	for _, binding := range bindings {
+		if binding == nil {
+			return nil, fmt.Errorf("static mac binding is nil")
+		}
+		uuid := odbi.getStaticMACBindingUUID(binding.LogicalPort, binding.IP)
+		if len(uuid) == 0 {
+			return nil, ErrorNotFound
+		}
+		condition := libovsdb.NewCondition("_uuid", "==", stringToGoUUID(uuid))
+		deleteOp := libovsdb.Operation{
+			Op:    opDelete,
+			Table: TableStaticMACBinding,
+			Where: []interface{}{condition},
+		}
+		operations = append(operations, deleteOp)
+	}
+	return &OvnCommand{operations, odbi, make([][]map[string]interface{}, len(operations))}, nil
+}
+
+func (odbi *ovndb) staticMACBindingGetImp(lrp string, ip string) (*StaticMACBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	if _, ok := odbi.cache[TableStaticMACBinding]; !ok {
+		return nil, ErrorSchema
+	}
+	uuid := odbi.getStaticMACBindingUUID(lrp, ip)
+	if len(uuid) == 0 {
+		return nil, ErrorNotFound
+	}
+	return odbi.rowToStaticMACBinding(uuid), nil
+}
+
+// staticMACBindingListImp lists the bindings of router port lrp, or all
+// bindings when lrp is empty
+func (odbi *ovndb) staticMACBindingListImp(lrp string) ([]*StaticMACBinding, error) {
+	odbi.cachemutex.RLock()
+	defer odbi.cachemutex.RUnlock()
+
+	cacheBinding, ok := odbi.cache[TableStaticMACBinding]
+	if !ok {
+		return nil, ErrorSchema
+	}
+
+	listBinding := make([]*StaticMACBinding, 0, len(cacheBinding))
+	for uuid, drows := range cacheBinding {
+		if len(lrp) > 0 && drows.Fields["logical_port"] != lrp {
+			continue
+		}
+		listBinding = append(listBinding, odbi.rowToStaticMACBinding(uuid))
+	}
+	return listBinding, nil
+}
+
+func (odbi *ovndb) rowToStaticMACBinding(uuid string) *StaticMACBinding {
+	cacheBinding, ok := odbi.cache[TableStaticMACBinding][uuid]
+	if !ok {
+		return nil
+	}
+
+	binding := &StaticMACBinding{
+		UUID:        uuid,
+		LogicalPort: cacheBinding.Fields["logical_port"].(string),
+		IP:          cacheBinding.Fields["ip"].(string),
+		MAC:         cacheBinding.Fields["mac"].(string),
+	}
+	if override, ok := cacheBinding.Fields["override_dynamic_mac"].(bool); ok {
+		binding.OverrideDynamicMAC = override
+	}
+	return binding
+}
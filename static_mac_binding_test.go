@@ -0,0 +1,117 @@
+/**
+ * Copyright (c) 2021 eBay Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ **/
+
+package goovn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	LR_SMB  = "lr-smb"
+	LRP_SMB = "lrp-smb"
+)
+
+func TestStaticMACBinding(t *testing.T) {
+	ovndbapi := getOVNClient(DBNB)
+	cmd, err := ovndbapi.LRAdd(LR_SMB, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd2, err := ovndbapi.LRPAdd(LR_SMB, LRP_SMB, "00:00:00:00:05:01", []string{"172.18.0.1/24"}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd, cmd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the table may hold no rows yet, which lists as empty
+	listed, err := ovndbapi.StaticMACBindingList(LRP_SMB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, listed)
+
+	_, err = ovndbapi.StaticMACBindingAdd(&StaticMACBinding{LogicalPort: FAKENOROUTER, IP: "172.18.0.10", MAC: "00:00:00:00:05:10"})
+	assert.Error(t, err)
+	_, err = ovndbapi.StaticMACBindingAdd(&StaticMACBinding{LogicalPort: LRP_SMB, IP: "172.18.0.10", MAC: "bogus"})
+	assert.Error(t, err)
+
+	bindings := []*StaticMACBinding{
+		{LogicalPort: LRP_SMB, IP: "172.18.0.10", MAC: "00:00:00:00:05:10"},
+		{LogicalPort: LRP_SMB, IP: "172.18.0.11", MAC: "00:00:00:00:05:11", OverrideDynamicMAC: true},
+	}
+	cmd, err = ovndbapi.StaticMACBindingAdd(bindings...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ovndbapi.StaticMACBindingAdd(bindings[0])
+	assert.Equal(t, ErrorExist, err)
+
+	list, err := ovndbapi.StaticMACBindingList(LRP_SMB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, len(list))
+
+	cmd, err = ovndbapi.StaticMACBindingUpdate(&StaticMACBinding{LogicalPort: LRP_SMB, IP: "172.18.0.10", MAC: "00:00:00:00:05:20", OverrideDynamicMAC: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	binding, err := ovndbapi.StaticMACBindingGet(LRP_SMB, "172.18.0.10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "00:00:00:00:05:20", binding.MAC)
+	assert.Equal(t, true, binding.OverrideDynamicMAC)
+
+	cmd, err = ovndbapi.StaticMACBindingDel(bindings...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err = ovndbapi.StaticMACBindingList(LRP_SMB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(list))
+	_, err = ovndbapi.StaticMACBindingGet(LRP_SMB, "172.18.0.10")
+	assert.Equal(t, ErrorNotFound, err)
+
+	cmd, err = ovndbapi.LRDel(LR_SMB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ovndbapi.Execute(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -115,6 +115,9 @@ func (s signal) OnMeterDelete(meter *Meter) {}
 func (s signal) OnMeterBandCreate(band *MeterBand) {}
 func (s signal) OnMeterBandDelete(band *MeterBand) {}
 
+func (s signal) OnForwardingGroupCreate(group *ForwardingGroup) {}
+func (s signal) OnForwardingGroupDelete(group *ForwardingGroup) {}
+
 // Create/delete chassis from south bound db
 func (s signal) OnChassisCreate(ch *Chassis) {}
 func (s signal) OnChassisDelete(ch *Chassis) {}
@@ -123,6 +126,21 @@ func (s signal) OnChassisDelete(ch *Chassis) {}
 func (s signal) OnEncapCreate(ch *Encap) {}
 func (s signal) OnEncapDelete(ch *Encap) {}
 
+func (s signal) OnPortBindingBind(pb *PortBinding)   {}
+func (s signal) OnPortBindingUnbind(pb *PortBinding) {}
+
+func (s signal) OnIGMPGroupCreate(group *IGMPGroup)           {}
+func (s signal) OnIGMPGroupDelete(group *IGMPGroup)           {}
+func (s signal) OnMulticastGroupCreate(group *MulticastGroup) {}
+func (s signal) OnMulticastGroupDelete(group *MulticastGroup) {}
+
+func (s signal) OnControllerEvent(ev *ControllerEvent) {}
+
+func (s signal) OnServiceMonitorStatusChange(sm *ServiceMonitor, oldStatus *string) {}
+
+func (s signal) OnChassisStale(l *ChassisLiveness)     {}
+func (s signal) OnChassisRecovered(l *ChassisLiveness) {}
+
 func buildOvnDbConfig(db string) *Config {
 	cfg := &Config{}
 	if db == DBNB || db == "" {
@@ -186,6 +204,13 @@ func buildOvnDbConfig(db string) *Config {
 	}
 
 	cfg.SignalCB = signal{}
+	if db == DBSB {
+		// monitor the opt-in tables too
+		cfg.TableCols = make(map[string][]string)
+		for _, table := range SBTablesOrder {
+			cfg.TableCols[table] = []string{}
+		}
+	}
 
 	return cfg
 }